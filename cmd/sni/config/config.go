@@ -42,6 +42,20 @@ var (
 	sniConfigs            = map[string]any{
 		"debug": false,
 
+		"rom_hash_type": "crc32",
+
+		// comma-delimited hexadecimal FX Pak Pro address:size pairs captured in snapshots (WRAM and SRAM):
+		"snapshot_regions": "F50000:20000,E00000:8000",
+		// regions not written by a snapshot restore while the game is running (the WRAM direct page and stack):
+		"snapshot_keep": "F50000:200",
+
+		"journal_enable":      false,
+		"journal_writes_only": false,
+
+		// delay before merging concurrent reads of a device and how long merged reads are served from a cache:
+		"read_coalesce_window": "0s",
+		"read_cache_max_age":   "0s",
+
 		"grpc_listen_host":    "0.0.0.0",
 		"grpc_listen_port":    8191,
 		"grpcweb_listen_port": 8190,
@@ -49,15 +63,20 @@ var (
 		"usb2snes_disable":      false,
 		"usb2snes_listen_addrs": "0.0.0.0:23074",
 		"fxpakpro_disable":      false,
+		"fxpakpro_sim":          false,
+		"fxpakpro_stream":       false,
 
-		"retroarch_disable":    false,
+		"retroarch_disable": false,
+		// comma-delimited host:port pairs or host:first-last port ranges; reloaded when the config file changes:
 		"retroarch_hosts":      "localhost:55355",
+		"retroarch_skip_hosts": "",
 		"retroarch_detect_log": false,
 
 		"luabridge_listen_host": "127.0.0.1",
 		"luabridge_listen_port": 65398,
 
 		"mock_enable": false,
+		"mock_rom":    "",
 
 		// sni_emunw_hosts is set dynamically when initializing the driver and initialization is conditioned on nwa_disable_old_range
 		// We are not setting it here
@@ -6,11 +6,13 @@ import (
 	"github.com/postfinance/single"
 	"log"
 	"net/http"
+	"os"
 	"runtime"
 	"sni/cmd/sni/appversion"
 	"sni/cmd/sni/config"
 	"sni/cmd/sni/logging"
 	"sni/cmd/sni/tray"
+	"sni/devices"
 	"sni/devices/snes/drivers/emunwa"
 	"sni/devices/snes/drivers/fxpakpro"
 	"sni/devices/snes/drivers/luabridge"
@@ -48,6 +50,11 @@ func main() {
 
 	config.InitDir()
 
+	// subcommands run without the tray or servers:
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		os.Exit(replay(os.Args[2:]))
+	}
+
 	var err error
 
 	// ensure only one instance of sni is running at a time:
@@ -102,5 +109,10 @@ func main() {
 	// start up a systray:
 	tray.CreateSystray()
 
+	// write out the journal's buffered records:
+	if err = devices.CloseDefaultJournal(); err != nil {
+		log.Printf("journal: %v\n", err)
+	}
+
 	log.Println("main: exit")
 }
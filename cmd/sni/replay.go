@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/drivers/emunwa"
+	"sni/devices/snes/drivers/fxpakpro"
+	"sni/devices/snes/drivers/luabridge"
+	"sni/devices/snes/drivers/mock"
+	"sni/devices/snes/drivers/retroarch"
+	"time"
+)
+
+// replay re-issues the writes recorded in a journal against a device; it returns the process exit code
+func replay(args []string) int {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(fs.Output(), "usage: sni replay [flags] <journal file>\n\nflags:\n")
+		fs.PrintDefaults()
+	}
+	uriFlag := fs.String("uri", "", "device URI to replay the writes against; defaults to each write's original device")
+	speed := fs.Float64("speed", 1, "speed multiplier relative to the recorded timing; 0 replays as fast as possible")
+	client := fs.String("client", "", "only replay writes made by this client")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 || *speed < 0 {
+		fs.Usage()
+		return 2
+	}
+
+	var uri *url.URL
+	if *uriFlag != "" {
+		var err error
+		if uri, err = url.Parse(*uriFlag); err != nil {
+			log.Printf("replay: %v\n", err)
+			return 2
+		}
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		log.Printf("replay: %v\n", err)
+		return 1
+	}
+	defer f.Close()
+
+	// load configuration and drivers without starting any servers:
+	config.Load()
+	fxpakpro.DriverInit()
+	emunwa.DriverInit()
+	luabridge.DriverInit()
+	retroarch.DriverInit()
+	mock.DriverInit()
+
+	ctx := devices.WithClient(context.Background(), "replay")
+	var last time.Time
+	count := 0
+	err = devices.ReadJournal(f, func(record devices.JournalRecord) (err error) {
+		if record.Kind != devices.JournalWrite {
+			return
+		}
+		if *client != "" && record.Client != *client {
+			return
+		}
+
+		// wait out the recorded time between writes:
+		if *speed > 0 && !last.IsZero() {
+			if delay := record.Timestamp.Sub(last); delay > 0 {
+				time.Sleep(time.Duration(float64(delay) / *speed))
+			}
+		}
+		last = record.Timestamp
+
+		target := uri
+		if target == nil {
+			if target, err = url.Parse(record.URI); err != nil {
+				return
+			}
+		}
+
+		var device devices.AutoCloseableDevice
+		if _, device, err = devices.DeviceByUri(target); err != nil {
+			return
+		}
+		_, err = device.MultiWriteMemory(ctx, devices.MemoryWriteRequest{
+			RequestAddress: record.Address,
+			Data:           record.Data,
+		})
+		if err != nil {
+			return fmt.Errorf("replay: write %s from %q at %s: %w", &record.Address, record.Client, record.Timestamp.Format(time.RFC3339Nano), err)
+		}
+
+		count++
+		return
+	})
+	if err != nil {
+		log.Printf("replay: %v\n", err)
+		return 1
+	}
+
+	log.Printf("replay: replayed %d writes\n", count)
+	return 0
+}
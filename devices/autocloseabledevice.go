@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"sni/cmd/sni/config"
 	"sni/protos/sni"
+	"time"
 
 	"google.golang.org/grpc/codes"
 )
@@ -17,13 +18,23 @@ import (
 type AutoCloseableDevice interface {
 	io.Closer
 	DeviceControl
+	DevicePowerControl
+	DeviceFrameControl
+	DeviceEmulator
 	DeviceMemory
+	DeviceMemoryCompareAndWrite
 	DeviceFilesystem
 	DeviceInfo
 	DeviceNWA
+	DeviceAssembly
 
 	URI() *url.URL
 	DeviceKey() string
+
+	// AcquireLease grants the caller exclusive write access to the device until the lease is released or its ttl
+	// elapses; passing the currently held leaseID renews the lease.
+	AcquireLease(ctx context.Context, leaseID string, ttl time.Duration, wait bool) (string, time.Time, error)
+	ReleaseLease(ctx context.Context, leaseID string) error
 }
 
 type autoCloseableDevice struct {
@@ -32,6 +43,10 @@ type autoCloseableDevice struct {
 	deviceKey string
 
 	logger *log.Logger
+	// journal is non-nil when memory access journaling is enabled
+	journal *Journal
+	// reads merges concurrent reads and is shared by all instances for the same device
+	reads *readScheduler
 }
 
 // var (
@@ -62,6 +77,8 @@ func NewAutoCloseableDevice(container DeviceContainer, uri *url.URL, deviceKey s
 		uri:       uri,
 		deviceKey: deviceKey,
 		logger:    logger,
+		journal:   DefaultJournal(),
+		reads:     readSchedulerFor(uri.Scheme + ":" + deviceKey),
 	}
 }
 
@@ -81,6 +98,7 @@ func (a *autoCloseableDevice) ensureOpened(ctx context.Context, use deviceUser)
 
 	// Check for fatal error and close device if so:
 	if derr, ok := err.(DeviceError); ok && derr.IsFatal() {
+		a.reads.invalidate()
 		oerr := device.Close()
 		if oerr != nil {
 			log.Printf("autoCloseableDevice.ensureOpened(): device.Close(): %v\n", oerr)
@@ -95,6 +113,17 @@ func (a *autoCloseableDevice) ensureOpened(ctx context.Context, use deviceUser)
 	return
 }
 
+func (a *autoCloseableDevice) journalRecord(ctx context.Context, kind JournalRecordKind, address AddressTuple, data []byte) JournalRecord {
+	return JournalRecord{
+		Kind:      kind,
+		Timestamp: time.Now(),
+		URI:       a.uri.String(),
+		Client:    ClientFromContext(ctx),
+		Address:   address,
+		Data:      data,
+	}
+}
+
 func (a *autoCloseableDevice) URI() *url.URL {
 	return a.uri
 }
@@ -103,6 +132,32 @@ func (a *autoCloseableDevice) DeviceKey() string {
 	return a.deviceKey
 }
 
+func (a *autoCloseableDevice) leaseKey() string {
+	return a.uri.Scheme + ":" + a.deviceKey
+}
+
+func (a *autoCloseableDevice) AcquireLease(ctx context.Context, leaseID string, ttl time.Duration, wait bool) (newLeaseID string, expiresAt time.Time, err error) {
+	if a.logger != nil {
+		a.logger.Printf("AcquireLease(%#v, %v, %#v) {\n", leaseID, ttl, wait)
+	}
+	newLeaseID, expiresAt, err = acquireLease(ctx, a.leaseKey(), leaseID, ttl, wait)
+	if a.logger != nil {
+		a.logger.Printf("AcquireLease(%#v, %v, %#v) } -> (%#v, %v, %#v)\n", leaseID, ttl, wait, newLeaseID, expiresAt, err)
+	}
+	return
+}
+
+func (a *autoCloseableDevice) ReleaseLease(ctx context.Context, leaseID string) (err error) {
+	if a.logger != nil {
+		a.logger.Printf("ReleaseLease(%#v) {\n", leaseID)
+	}
+	err = releaseLease(a.leaseKey(), leaseID)
+	if a.logger != nil {
+		a.logger.Printf("ReleaseLease(%#v) } -> (%#v)\n", leaseID, err)
+	}
+	return
+}
+
 func (a *autoCloseableDevice) Close() error {
 	d, ok := a.container.GetDevice(a.deviceKey)
 	if !ok {
@@ -120,6 +175,11 @@ func (a *autoCloseableDevice) Close() error {
 }
 
 func (a *autoCloseableDevice) ResetSystem(ctx context.Context) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	// cached reads may be stale afterwards:
+	defer a.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("ResetSystem() {\n")
@@ -134,6 +194,10 @@ func (a *autoCloseableDevice) ResetSystem(ctx context.Context) (err error) {
 }
 
 func (a *autoCloseableDevice) ResetToMenu(ctx context.Context) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	defer a.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("ResetToMenu() {\n")
@@ -148,6 +212,9 @@ func (a *autoCloseableDevice) ResetToMenu(ctx context.Context) (err error) {
 }
 
 func (a *autoCloseableDevice) PauseUnpause(ctx context.Context, pausedState bool) (ok bool, err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("PauseUnpause(%#v) {\n", pausedState)
@@ -162,6 +229,9 @@ func (a *autoCloseableDevice) PauseUnpause(ctx context.Context, pausedState bool
 }
 
 func (a *autoCloseableDevice) PauseToggle(ctx context.Context) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("PauseToggle() {\n")
@@ -175,6 +245,132 @@ func (a *autoCloseableDevice) PauseToggle(ctx context.Context) (err error) {
 	return
 }
 
+func (a *autoCloseableDevice) PowerCycle(ctx context.Context) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	defer a.reads.invalidate()
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		pwr, ok := device.(DevicePowerControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DevicePowerControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("PowerCycle() {\n")
+		}
+		err = pwr.PowerCycle(ctx)
+		if a.logger != nil {
+			a.logger.Printf("PowerCycle() } -> (%#v)\n", err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) SetRealTimeClock(ctx context.Context, t time.Time) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		pwr, ok := device.(DevicePowerControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DevicePowerControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("SetRealTimeClock(%v) {\n", t)
+		}
+		err = pwr.SetRealTimeClock(ctx, t)
+		if a.logger != nil {
+			a.logger.Printf("SetRealTimeClock(%v) } -> (%#v)\n", t, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) FrameAdvance(ctx context.Context, frames uint32) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	defer a.reads.invalidate()
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		fc, ok := device.(DeviceFrameControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceFrameControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("FrameAdvance(%d) {\n", frames)
+		}
+		err = fc.FrameAdvance(ctx, frames)
+		if a.logger != nil {
+			a.logger.Printf("FrameAdvance(%d) } -> (%#v)\n", frames, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) SaveState(ctx context.Context, slot int) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		emu, ok := device.(DeviceEmulator)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceEmulator not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("SaveState(%d) {\n", slot)
+		}
+		err = emu.SaveState(ctx, slot)
+		if a.logger != nil {
+			a.logger.Printf("SaveState(%d) } -> (%#v)\n", slot, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) LoadState(ctx context.Context, slot int) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	defer a.reads.invalidate()
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		emu, ok := device.(DeviceEmulator)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceEmulator not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("LoadState(%d) {\n", slot)
+		}
+		err = emu.LoadState(ctx, slot)
+		if a.logger != nil {
+			a.logger.Printf("LoadState(%d) } -> (%#v)\n", slot, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) Screenshot(ctx context.Context) (err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		emu, ok := device.(DeviceEmulator)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceEmulator not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("Screenshot() {\n")
+		}
+		err = emu.Screenshot(ctx)
+		if a.logger != nil {
+			a.logger.Printf("Screenshot() } -> (%#v)\n", err)
+		}
+		return
+	})
+	return
+}
+
 func (a *autoCloseableDevice) RequiresMemoryMappingForAddressSpace(ctx context.Context, addressSpace sni.AddressSpace) (rsp bool, err error) {
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
@@ -204,6 +400,19 @@ func (a *autoCloseableDevice) RequiresMemoryMappingForAddress(ctx context.Contex
 }
 
 func (a *autoCloseableDevice) MultiReadMemory(ctx context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	rsp, err = a.reads.read(ctx, reads, a.multiReadMemory)
+	if err == nil && a.journal != nil && a.journal.RecordsReads() {
+		records := make([]JournalRecord, 0, len(rsp))
+		for i := range rsp {
+			records = append(records, a.journalRecord(ctx, JournalRead, rsp[i].RequestAddress, rsp[i].Data))
+		}
+		a.journal.Record(records...)
+	}
+	return
+}
+
+// multiReadMemory performs the reads merged by the read scheduler
+func (a *autoCloseableDevice) multiReadMemory(ctx context.Context, reads []MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("MultiReadMemory(%#v) {\n", reads)
@@ -218,6 +427,10 @@ func (a *autoCloseableDevice) MultiReadMemory(ctx context.Context, reads ...Memo
 }
 
 func (a *autoCloseableDevice) MultiWriteMemory(ctx context.Context, writes ...MemoryWriteRequest) (rsp []MemoryWriteResponse, err error) {
+	defer a.reads.invalidate()
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("MultiWriteMemory(%#v) {\n", writes)
@@ -228,6 +441,38 @@ func (a *autoCloseableDevice) MultiWriteMemory(ctx context.Context, writes ...Me
 		}
 		return
 	})
+	if err == nil && a.journal != nil {
+		records := make([]JournalRecord, 0, len(writes))
+		for i := range writes {
+			records = append(records, a.journalRecord(ctx, JournalWrite, writes[i].RequestAddress, writes[i].Data))
+		}
+		a.journal.Record(records...)
+	}
+	return
+}
+
+func (a *autoCloseableDevice) CompareAndWriteMemory(ctx context.Context, request MemoryCompareAndWriteRequest) (rsp MemoryCompareAndWriteResponse, err error) {
+	defer a.reads.invalidate()
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		cas, ok := device.(DeviceMemoryCompareAndWrite)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceMemoryCompareAndWrite not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("CompareAndWriteMemory(%#v) {\n", request)
+		}
+		rsp, err = cas.CompareAndWriteMemory(ctx, request)
+		if a.logger != nil {
+			a.logger.Printf("CompareAndWriteMemory(%#v) } -> (%#v, %#v)\n", request, rsp, err)
+		}
+		return
+	})
+	if err == nil && rsp.Written && a.journal != nil {
+		a.journal.Record(a.journalRecord(ctx, JournalWrite, request.RequestAddress, request.Data))
+	}
 	return
 }
 
@@ -268,6 +513,9 @@ func (a *autoCloseableDevice) ReadDirectory(ctx context.Context, path string) (r
 }
 
 func (a *autoCloseableDevice) MakeDirectory(ctx context.Context, path string) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -286,6 +534,9 @@ func (a *autoCloseableDevice) MakeDirectory(ctx context.Context, path string) (e
 }
 
 func (a *autoCloseableDevice) RemoveFile(ctx context.Context, path string) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -304,6 +555,9 @@ func (a *autoCloseableDevice) RemoveFile(ctx context.Context, path string) (err
 }
 
 func (a *autoCloseableDevice) RenameFile(ctx context.Context, path, newFilename string) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -322,6 +576,10 @@ func (a *autoCloseableDevice) RenameFile(ctx context.Context, path, newFilename
 }
 
 func (a *autoCloseableDevice) PutFile(ctx context.Context, path string, size uint32, r io.Reader, progress ProgressReportFunc) (n uint32, err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	defer a.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -358,6 +616,10 @@ func (a *autoCloseableDevice) GetFile(ctx context.Context, path string, w io.Wri
 }
 
 func (a *autoCloseableDevice) BootFile(ctx context.Context, path string) (err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	defer a.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -376,6 +638,10 @@ func (a *autoCloseableDevice) BootFile(ctx context.Context, path string) (err er
 }
 
 func (a *autoCloseableDevice) NWACommand(ctx context.Context, cmd string, args string, binaryArg []byte) (asciiReply []map[string]string, binaryReply []byte, err error) {
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	defer a.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		nwa, ok := device.(DeviceNWA)
 		if !ok {
@@ -395,3 +661,25 @@ func (a *autoCloseableDevice) NWACommand(ctx context.Context, cmd string, args s
 	})
 	return
 }
+
+func (a *autoCloseableDevice) ExecuteASM(ctx context.Context, code []byte, waitForCompletion bool, results ...MemoryReadRequest) (completed bool, rsp []MemoryReadResponse, err error) {
+	defer a.reads.invalidate()
+	if err = checkLease(ctx, a.leaseKey()); err != nil {
+		return
+	}
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		asm, ok := device.(DeviceAssembly)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceAssembly not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("ExecuteASM(%d bytes, %#v, %#v) {\n", len(code), waitForCompletion, results)
+		}
+		completed, rsp, err = asm.ExecuteASM(ctx, code, waitForCompletion, results...)
+		if a.logger != nil {
+			a.logger.Printf("ExecuteASM(%d bytes, %#v, %#v) } -> (%#v, %#v, %#v)\n", len(code), waitForCompletion, results, completed, rsp, err)
+		}
+		return
+	})
+	return
+}
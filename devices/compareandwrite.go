@@ -0,0 +1,62 @@
+package devices
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sni/protos/sni"
+)
+
+// CompareAndWriteWhilePaused is a best-effort CompareAndWriteMemory for emulators that cannot compare and write
+// atomically. It attempts to pause emulation, reads and compares the memory, writes it if matched, then unpauses
+// emulation if it paused it. Emulation already paused is left paused; see PauseEmulation for emulators which do not
+// report whether they are paused. If pausing fails, the read-compare-write is still performed but without any
+// guarantee of atomicity.
+func CompareAndWriteWhilePaused(
+	ctx context.Context,
+	device interface {
+		DeviceMemory
+		DeviceControl
+		FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error)
+	},
+	request MemoryCompareAndWriteRequest,
+) (rsp MemoryCompareAndWriteResponse, err error) {
+	if len(request.Compare) != len(request.Data) {
+		err = DeviceNonFatal(fmt.Sprintf("compare length %d must equal data length %d", len(request.Compare), len(request.Data)), nil)
+		return
+	}
+
+	rsp.RequestAddress = request.RequestAddress
+
+	resume := PauseEmulation(ctx, device)
+	defer func() {
+		if rerr := resume(); rerr != nil && err == nil {
+			err = rerr
+		}
+	}()
+
+	var mrsps []MemoryReadResponse
+	mrsps, err = device.MultiReadMemory(ctx, MemoryReadRequest{
+		RequestAddress: request.RequestAddress,
+		Size:           len(request.Compare),
+	})
+	if err != nil {
+		return
+	}
+	rsp.DeviceAddress = mrsps[0].DeviceAddress
+
+	if !bytes.Equal(mrsps[0].Data, request.Compare) {
+		return
+	}
+
+	_, err = device.MultiWriteMemory(ctx, MemoryWriteRequest{
+		RequestAddress: request.RequestAddress,
+		Data:           request.Data,
+	})
+	if err != nil {
+		return
+	}
+
+	rsp.Written = true
+	return
+}
@@ -0,0 +1,92 @@
+package devices
+
+import (
+	"context"
+	"sni/protos/sni"
+	"testing"
+)
+
+// fakeEmulator reports status as its emulation status and records the pause requests it receives
+type fakeEmulator struct {
+	*fakeMemory
+	status string
+	pauses []bool
+}
+
+func (f *fakeEmulator) RequiresMemoryMappingForAddressSpace(context.Context, sni.AddressSpace) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeEmulator) RequiresMemoryMappingForAddress(context.Context, AddressTuple) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeEmulator) MultiReadMemory(ctx context.Context, reads ...MemoryReadRequest) ([]MemoryReadResponse, error) {
+	return f.read(ctx, reads)
+}
+
+func (f *fakeEmulator) MultiWriteMemory(ctx context.Context, writes ...MemoryWriteRequest) (rsp []MemoryWriteResponse, err error) {
+	for _, w := range writes {
+		copy(f.mem[w.RequestAddress.Address:], w.Data)
+		rsp = append(rsp, MemoryWriteResponse{RequestAddress: w.RequestAddress, DeviceAddress: w.RequestAddress, Size: len(w.Data)})
+	}
+	return
+}
+
+func (f *fakeEmulator) ResetSystem(context.Context) error { return nil }
+func (f *fakeEmulator) ResetToMenu(context.Context) error { return nil }
+func (f *fakeEmulator) PauseToggle(context.Context) error { return nil }
+
+func (f *fakeEmulator) PauseUnpause(ctx context.Context, pausedState bool) (bool, error) {
+	f.pauses = append(f.pauses, pausedState)
+	return pausedState, nil
+}
+
+func (f *fakeEmulator) FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error) {
+	for range fields {
+		values = append(values, f.status)
+	}
+	return
+}
+
+func TestCompareAndWriteWhilePaused(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		wantPauses []bool
+	}{
+		{name: "running is paused and unpaused", status: "running", wantPauses: []bool{true, false}},
+		{name: "paused stays paused", status: "PAUSED", wantPauses: nil},
+		{name: "unknown status is paused and unpaused", status: "", wantPauses: []bool{true, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakeEmulator{fakeMemory: newFakeMemory(), status: tt.status}
+			address := AddressTuple{Address: 0x10, AddressSpace: sni.AddressSpace_FxPakPro}
+
+			rsp, err := CompareAndWriteWhilePaused(context.Background(), f, MemoryCompareAndWriteRequest{
+				RequestAddress: address,
+				Compare:        []byte{0x10, 0x11},
+				Data:           []byte{0xAA, 0xBB},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !rsp.Written {
+				t.Fatal("expected write")
+			}
+			if f.mem[0x10] != 0xAA || f.mem[0x11] != 0xBB {
+				t.Fatalf("memory = %02x, want aabb", f.mem[0x10:0x12])
+			}
+
+			if len(f.pauses) != len(tt.wantPauses) {
+				t.Fatalf("pauses = %v, want %v", f.pauses, tt.wantPauses)
+			}
+			for i := range f.pauses {
+				if f.pauses[i] != tt.wantPauses[i] {
+					t.Fatalf("pauses = %v, want %v", f.pauses, tt.wantPauses)
+				}
+			}
+		})
+	}
+}
@@ -1,9 +1,14 @@
 package devices
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"github.com/alttpo/snes/mapping/util"
 	"google.golang.org/grpc/codes"
+	"io"
+	"net"
+	"os"
 )
 
 type ErrDeviceDisconnected struct {
@@ -25,6 +30,112 @@ func (e *CodedError) Unwrap() error { return e.Cause }
 
 func WithCode(code codes.Code, cause error) *CodedError { return &CodedError{code, cause} }
 
+// ErrorKind classifies device errors so that clients can react to them without parsing messages
+type ErrorKind int
+
+const (
+	ErrorKindUnknown ErrorKind = iota
+	// ErrorKindTimeout means the device did not respond in time
+	ErrorKindTimeout
+	// ErrorKindDisconnected means the connection to the device was lost
+	ErrorKindDisconnected
+	// ErrorKindBusy means the device is in use by another client or operation
+	ErrorKindBusy
+	// ErrorKindAddressOutOfRange means the requested address cannot be mapped for the device
+	ErrorKindAddressOutOfRange
+	// ErrorKindUnsupportedDomain means the device does not expose the requested memory domain or feature
+	ErrorKindUnsupportedDomain
+	// ErrorKindProtocolViolation means the device replied with something unexpected
+	ErrorKindProtocolViolation
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindTimeout:
+		return "TIMEOUT"
+	case ErrorKindDisconnected:
+		return "DISCONNECTED"
+	case ErrorKindBusy:
+		return "BUSY"
+	case ErrorKindAddressOutOfRange:
+		return "ADDRESS_OUT_OF_RANGE"
+	case ErrorKindUnsupportedDomain:
+		return "UNSUPPORTED_DOMAIN"
+	case ErrorKindProtocolViolation:
+		return "PROTOCOL_VIOLATION"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Code returns the gRPC status code that corresponds to the error kind
+func (k ErrorKind) Code() codes.Code {
+	switch k {
+	case ErrorKindTimeout:
+		return codes.DeadlineExceeded
+	case ErrorKindDisconnected:
+		return codes.Unavailable
+	case ErrorKindBusy:
+		return codes.Aborted
+	case ErrorKindAddressOutOfRange:
+		return codes.OutOfRange
+	case ErrorKindUnsupportedDomain:
+		return codes.Unimplemented
+	case ErrorKindProtocolViolation:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
+
+// KindedError is implemented by errors that carry an explicit ErrorKind
+type KindedError interface {
+	error
+	Kind() ErrorKind
+}
+
+// KindOf classifies err by the first explicit ErrorKind found in its chain, falling back to recognizing well-known
+// causes such as timeouts, closed connections and unmapped addresses
+func KindOf(err error) ErrorKind {
+	if err == nil {
+		return ErrorKindUnknown
+	}
+
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if k, ok := e.(KindedError); ok && k.Kind() != ErrorKindUnknown {
+			return k.Kind()
+		}
+	}
+
+	return inferKind(err)
+}
+
+func inferKind(err error) ErrorKind {
+	var netErr net.Error
+	var disconnected ErrDeviceDisconnected
+	var coded *CodedError
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, os.ErrDeadlineExceeded):
+		return ErrorKindTimeout
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ErrorKindTimeout
+	case errors.As(err, &disconnected), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, net.ErrClosed):
+		return ErrorKindDisconnected
+	case errors.Is(err, util.ErrUnmappedAddress):
+		return ErrorKindAddressOutOfRange
+	case errors.As(err, &coded):
+		switch coded.Code {
+		case codes.Aborted:
+			return ErrorKindBusy
+		case codes.Unimplemented:
+			return ErrorKindUnsupportedDomain
+		case codes.DeadlineExceeded:
+			return ErrorKindTimeout
+		}
+	}
+	return ErrorKindUnknown
+}
+
 type DeviceError interface {
 	error
 	IsFatal() bool
@@ -51,11 +162,40 @@ type Error struct {
 	msg     string
 	cause   error
 	isFatal bool
+	kind    ErrorKind
 }
 
 func (e *Error) Error() string { return e.msg }
 func (e *Error) Unwrap() error { return e.cause }
 func (e *Error) IsFatal() bool { return e.isFatal }
+func (e *Error) Kind() ErrorKind {
+	if e.kind == ErrorKindUnknown {
+		return inferKind(e.cause)
+	}
+	return e.kind
+}
+
+func DeviceNonFatal(msg string, cause error) DeviceError {
+	return &Error{msg, cause, false, ErrorKindUnknown}
+}
+func DeviceFatal(msg string, cause error) DeviceError {
+	return &Error{msg, cause, true, ErrorKindUnknown}
+}
+
+// DeviceNonFatalKind creates a non-fatal device error of an explicit kind
+func DeviceNonFatalKind(kind ErrorKind, msg string, cause error) DeviceError {
+	return &Error{msg, cause, false, kind}
+}
 
-func DeviceNonFatal(msg string, cause error) DeviceError { return &Error{msg, cause, false} }
-func DeviceFatal(msg string, cause error) DeviceError    { return &Error{msg, cause, true} }
+// DeviceFatalKind creates a fatal device error of an explicit kind
+func DeviceFatalKind(kind ErrorKind, msg string, cause error) DeviceError {
+	return &Error{msg, cause, true, kind}
+}
+
+// WithKind tags cause with an explicit ErrorKind while preserving its message and fatality
+func WithKind(kind ErrorKind, cause error) error {
+	if cause == nil {
+		return nil
+	}
+	return &Error{cause.Error(), cause, IsFatal(cause), kind}
+}
@@ -0,0 +1,118 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/alttpo/snes/mapping/util"
+	"google.golang.org/grpc/codes"
+)
+
+// timeoutError is a net.Error that reports whether it timed out
+type timeoutError bool
+
+func (e timeoutError) Error() string   { return "i/o" }
+func (e timeoutError) Timeout() bool   { return bool(e) }
+func (e timeoutError) Temporary() bool { return false }
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorKind
+	}{
+		{"nil", nil, ErrorKindUnknown},
+		{"plain", errors.New("plain"), ErrorKindUnknown},
+		{"context deadline", context.DeadlineExceeded, ErrorKindTimeout},
+		{"os deadline", fmt.Errorf("read: %w", os.ErrDeadlineExceeded), ErrorKindTimeout},
+		{"net timeout", &net.OpError{Op: "read", Err: timeoutError(true)}, ErrorKindTimeout},
+		{"net error without timeout", &net.OpError{Op: "read", Err: timeoutError(false)}, ErrorKindUnknown},
+		{"disconnected", ErrDeviceDisconnected{errors.New("gone")}, ErrorKindDisconnected},
+		{"eof", fmt.Errorf("read: %w", io.EOF), ErrorKindDisconnected},
+		{"unexpected eof", io.ErrUnexpectedEOF, ErrorKindDisconnected},
+		{"closed", &net.OpError{Op: "write", Err: net.ErrClosed}, ErrorKindDisconnected},
+		{"unmapped", fmt.Errorf("map: %w", util.ErrUnmappedAddress), ErrorKindAddressOutOfRange},
+		{"coded aborted", WithCode(codes.Aborted, errors.New("busy")), ErrorKindBusy},
+		{"coded unimplemented", WithCode(codes.Unimplemented, errors.New("nope")), ErrorKindUnsupportedDomain},
+		{"coded deadline", WithCode(codes.DeadlineExceeded, errors.New("slow")), ErrorKindTimeout},
+		{"coded other", WithCode(codes.InvalidArgument, errors.New("bad")), ErrorKindUnknown},
+		{"device error inferred", DeviceNonFatal("read", io.EOF), ErrorKindDisconnected},
+		{"device error explicit", DeviceFatalKind(ErrorKindProtocolViolation, "reply", io.EOF), ErrorKindProtocolViolation},
+		{"explicit kind wrapped", fmt.Errorf("op: %w", DeviceNonFatalKind(ErrorKindBusy, "busy", nil)), ErrorKindBusy},
+		{"outermost explicit kind wins", WithKind(ErrorKindUnsupportedDomain, WithKind(ErrorKindBusy, io.EOF)), ErrorKindUnsupportedDomain},
+		{"unknown kind inferred from cause", WithKind(ErrorKindUnknown, io.EOF), ErrorKindDisconnected},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KindOf(tt.err); got != tt.want {
+				t.Errorf("KindOf(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorKind_Code(t *testing.T) {
+	tests := []struct {
+		kind     ErrorKind
+		wantName string
+		wantCode codes.Code
+	}{
+		{ErrorKindUnknown, "UNKNOWN", codes.Unknown},
+		{ErrorKindTimeout, "TIMEOUT", codes.DeadlineExceeded},
+		{ErrorKindDisconnected, "DISCONNECTED", codes.Unavailable},
+		{ErrorKindBusy, "BUSY", codes.Aborted},
+		{ErrorKindAddressOutOfRange, "ADDRESS_OUT_OF_RANGE", codes.OutOfRange},
+		{ErrorKindUnsupportedDomain, "UNSUPPORTED_DOMAIN", codes.Unimplemented},
+		{ErrorKindProtocolViolation, "PROTOCOL_VIOLATION", codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantName, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.wantName {
+				t.Errorf("String() = %v, want %v", got, tt.wantName)
+			}
+			if got := tt.kind.Code(); got != tt.wantCode {
+				t.Errorf("Code() = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestWithKind(t *testing.T) {
+	tests := []struct {
+		name      string
+		cause     error
+		kind      ErrorKind
+		wantFatal bool
+	}{
+		{"plain", errors.New("plain"), ErrorKindBusy, false},
+		{"non-fatal", DeviceNonFatal("non-fatal", nil), ErrorKindTimeout, false},
+		{"fatal", DeviceFatal("fatal", nil), ErrorKindDisconnected, true},
+		{"wrapped fatal", fmt.Errorf("op: %w", DeviceFatal("fatal", nil)), ErrorKindProtocolViolation, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := WithKind(tt.kind, tt.cause)
+			if err.Error() != tt.cause.Error() {
+				t.Errorf("Error() = %q, want %q", err.Error(), tt.cause.Error())
+			}
+			if !errors.Is(err, tt.cause) {
+				t.Errorf("WithKind() does not wrap %v", tt.cause)
+			}
+			if got := IsFatal(err); got != tt.wantFatal {
+				t.Errorf("IsFatal() = %v, want %v", got, tt.wantFatal)
+			}
+			if got := KindOf(err); got != tt.kind {
+				t.Errorf("KindOf() = %v, want %v", got, tt.kind)
+			}
+		})
+	}
+
+	if err := WithKind(ErrorKindBusy, nil); err != nil {
+		t.Errorf("WithKind(nil) = %v, want nil", err)
+	}
+}
@@ -0,0 +1,13 @@
+package devices
+
+// UnregisterAllDrivers lets external tests register real drivers such as the mock driver
+var UnregisterAllDrivers = unregisterAllDrivers
+
+// Detect runs one detection of the watcher's loop so that external tests need not wait for its interval
+func (w *DeviceWatcher) Detect() {
+	w.lock.Lock()
+	stop := w.stop
+	w.lock.Unlock()
+
+	w.detect(stop)
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/url"
 	"sni/protos/sni"
+	"time"
 )
 
 type Driver interface {
@@ -73,6 +74,26 @@ type MemoryWriteResponse struct {
 	Size int
 }
 
+type DeviceMemoryCompareAndWrite interface {
+	CompareAndWriteMemory(ctx context.Context, request MemoryCompareAndWriteRequest) (MemoryCompareAndWriteResponse, error)
+}
+
+type MemoryCompareAndWriteRequest struct {
+	RequestAddress AddressTuple
+
+	// Compare must be the same length as Data
+	Compare []byte
+	Data    []byte
+}
+
+type MemoryCompareAndWriteResponse struct {
+	RequestAddress AddressTuple
+	DeviceAddress  AddressTuple
+
+	// Written is true if Compare matched and Data was written
+	Written bool
+}
+
 type DeviceControl interface {
 	ResetSystem(ctx context.Context) error
 	ResetToMenu(ctx context.Context) error
@@ -81,6 +102,29 @@ type DeviceControl interface {
 	PauseToggle(ctx context.Context) error
 }
 
+type DevicePowerControl interface {
+	PowerCycle(ctx context.Context) error
+	// SetRealTimeClock sets the cartridge's real-time clock to the local wall-clock time of t
+	SetRealTimeClock(ctx context.Context, t time.Time) error
+}
+
+type DeviceFrameControl interface {
+	// FrameAdvance pauses emulation if it is running and then runs the given number of frames
+	FrameAdvance(ctx context.Context, frames uint32) error
+}
+
+// CurrentStateSlot selects the emulator's current save state slot
+const CurrentStateSlot = -1
+
+type DeviceEmulator interface {
+	// SaveState saves the emulation state to the given slot or to the current slot if slot is CurrentStateSlot
+	SaveState(ctx context.Context, slot int) error
+	// LoadState loads the emulation state from the given slot or from the current slot if slot is CurrentStateSlot
+	LoadState(ctx context.Context, slot int) error
+	// Screenshot saves a screenshot where the emulator is configured to keep them
+	Screenshot(ctx context.Context) error
+}
+
 type DeviceFilesystem interface {
 	ReadDirectory(ctx context.Context, path string) ([]DirEntry, error)
 	MakeDirectory(ctx context.Context, path string) error
@@ -103,6 +147,12 @@ type DeviceInfo interface {
 	FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error)
 }
 
+type DeviceAssembly interface {
+	// ExecuteASM uploads the given machine code to execute once on the device; if waitForCompletion is true or any
+	// results are requested, it waits for the code to complete and then reads the results.
+	ExecuteASM(ctx context.Context, code []byte, waitForCompletion bool, results ...MemoryReadRequest) (completed bool, rsp []MemoryReadResponse, err error)
+}
+
 type DeviceNWA interface {
 	NWACommand(ctx context.Context, cmd string, args string, binaryArg []byte) (asciiReply []map[string]string, binaryReply []byte, err error)
 }
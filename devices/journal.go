@@ -0,0 +1,349 @@
+package devices
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"sni/protos/sni"
+	"sync"
+	"time"
+)
+
+type clientKeyType int
+
+var clientKey clientKeyType
+
+// WithClient returns a context that names the client a request originates from, e.g. a gRPC peer address or a
+// usb2snes client name, for the journal
+func WithClient(ctx context.Context, client string) context.Context {
+	if client == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, clientKey, client)
+}
+
+// ClientFromContext returns the name of the client a request originates from, if known
+func ClientFromContext(ctx context.Context) string {
+	client, _ := ctx.Value(clientKey).(string)
+	return client
+}
+
+// Journal files are laid out as:
+//
+//   - magic: "SNIJ"
+//   - version: 1 byte, journalVersion
+//   - records until the end of the file:
+//   - kind: 1 byte, JournalRecordKind
+//   - timestamp: varint, nanoseconds since the unix epoch
+//   - device URI, client: each a uvarint length followed by the UTF-8 bytes
+//   - address: uvarint
+//   - address space, memory mapping: 1 byte each
+//   - data: uvarint length followed by the bytes read or written
+const (
+	journalMagic   = "SNIJ"
+	journalVersion = 1
+	journalExt     = ".snij"
+
+	// journalMaxField guards against allocating for a corrupt length:
+	journalMaxField = 16 << 20
+)
+
+type JournalRecordKind uint8
+
+const (
+	JournalRead JournalRecordKind = iota
+	JournalWrite
+)
+
+func (k JournalRecordKind) String() string {
+	switch k {
+	case JournalRead:
+		return "read"
+	case JournalWrite:
+		return "write"
+	default:
+		return fmt.Sprintf("JournalRecordKind(%d)", uint8(k))
+	}
+}
+
+// JournalRecord is a single memory read or write recorded in the journal
+type JournalRecord struct {
+	Kind      JournalRecordKind
+	Timestamp time.Time
+	URI       string
+	Client    string
+	Address   AddressTuple
+	Data      []byte
+}
+
+// Journal records memory reads and writes of all devices to a binary file. Records are encoded by the caller and
+// written to the file by a background goroutine, which flushes them periodically and on Close, so that recording
+// does not wait for the disk.
+type Journal struct {
+	f *os.File
+	w *bufio.Writer
+
+	writesOnly bool
+
+	records   chan []byte
+	closing   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+const (
+	// journalFlushInterval is how often buffered records are flushed to the file:
+	journalFlushInterval = time.Second
+	// journalQueueSize is the number of Record calls that may be queued before Record waits for the file:
+	journalQueueSize = 1024
+)
+
+var (
+	journalOnce    sync.Once
+	defaultJournal *Journal
+)
+
+// DefaultJournal returns the journal that devices record to, or nil if journaling is disabled. The journal is
+// created in the journal directory of the SNI configuration directory when first needed.
+func DefaultJournal() *Journal {
+	if !config.Config.GetBool("journal_enable") {
+		return nil
+	}
+
+	journalOnce.Do(func() {
+		dir := filepath.Join(config.Dir, "journal")
+		if err := os.MkdirAll(dir, 0755|os.ModeDir); err != nil {
+			log.Printf("journal: %v\n", err)
+			return
+		}
+
+		path := filepath.Join(dir, time.Now().Format("20060102-150405")+journalExt)
+		j, err := CreateJournal(path, config.Config.GetBool("journal_writes_only"))
+		if err != nil {
+			log.Printf("journal: %v\n", err)
+			return
+		}
+
+		if j.writesOnly {
+			log.Printf("journal: recording memory writes to %s\n", path)
+		} else {
+			log.Printf("journal: recording memory access to %s\n", path)
+		}
+		defaultJournal = j
+	})
+
+	return defaultJournal
+}
+
+// CloseDefaultJournal flushes and closes the default journal if it was created. No journal is created afterwards.
+func CloseDefaultJournal() error {
+	journalOnce.Do(func() {})
+	if defaultJournal == nil {
+		return nil
+	}
+	return defaultJournal.Close()
+}
+
+// CreateJournal creates a new journal file at path. If writesOnly is true, reads are not recorded.
+func CreateJournal(path string, writesOnly bool) (j *Journal, err error) {
+	return createJournal(path, writesOnly, journalFlushInterval)
+}
+
+func createJournal(path string, writesOnly bool, flushInterval time.Duration) (j *Journal, err error) {
+	var f *os.File
+	if f, err = os.Create(path); err != nil {
+		return
+	}
+
+	j = &Journal{
+		f:          f,
+		w:          bufio.NewWriterSize(f, 64*1024),
+		writesOnly: writesOnly,
+		records:    make(chan []byte, journalQueueSize),
+		closing:    make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	_, _ = j.w.WriteString(journalMagic)
+	_ = j.w.WriteByte(journalVersion)
+	if err = j.w.Flush(); err != nil {
+		_ = f.Close()
+		j = nil
+		return
+	}
+
+	go j.run(flushInterval)
+	return
+}
+
+// run writes queued records to the file and flushes them every flushInterval until the journal is closed
+func (j *Journal) run(flushInterval time.Duration) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case b := <-j.records:
+			_, _ = j.w.Write(b)
+		case <-ticker.C:
+			if err := j.w.Flush(); err != nil {
+				log.Printf("journal: %v\n", err)
+			}
+		case <-j.closing:
+			// write what was queued before closing:
+			for {
+				select {
+				case b := <-j.records:
+					_, _ = j.w.Write(b)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close flushes and closes the journal file. Records recorded after Close are dropped.
+func (j *Journal) Close() (err error) {
+	j.closeOnce.Do(func() {
+		close(j.closing)
+		<-j.done
+
+		if err = j.w.Flush(); err != nil {
+			_ = j.f.Close()
+			return
+		}
+		err = j.f.Close()
+	})
+	return
+}
+
+// RecordsReads reports whether the journal records reads as well as writes
+func (j *Journal) RecordsReads() bool {
+	return !j.writesOnly
+}
+
+func appendBytes(b []byte, v []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+func appendRecord(b []byte, r *JournalRecord) []byte {
+	b = append(b, byte(r.Kind))
+	b = binary.AppendVarint(b, r.Timestamp.UnixNano())
+	b = appendBytes(b, []byte(r.URI))
+	b = appendBytes(b, []byte(r.Client))
+	b = binary.AppendUvarint(b, uint64(r.Address.Address))
+	b = append(b, byte(r.Address.AddressSpace), byte(r.Address.MemoryMapping))
+	return appendBytes(b, r.Data)
+}
+
+// Record queues the records to be written to the journal. The records are encoded before Record returns so their
+// data may be reused afterwards. Reads are skipped if the journal only records writes.
+func (j *Journal) Record(records ...JournalRecord) {
+	var b []byte
+	for i := range records {
+		if j.writesOnly && records[i].Kind != JournalWrite {
+			continue
+		}
+		b = appendRecord(b, &records[i])
+	}
+	if len(b) == 0 {
+		return
+	}
+
+	select {
+	case j.records <- b:
+	case <-j.closing:
+	}
+}
+
+// ReadJournal calls fn for each record of the journal read from r in order until fn returns an error
+func ReadJournal(r io.Reader, fn func(record JournalRecord) error) (err error) {
+	br := bufio.NewReader(r)
+
+	header := make([]byte, len(journalMagic)+1)
+	if _, err = io.ReadFull(br, header); err != nil {
+		return fmt.Errorf("journal: %w", err)
+	}
+	if string(header[:len(journalMagic)]) != journalMagic {
+		return fmt.Errorf("journal: not a journal file")
+	}
+	if version := header[len(journalMagic)]; version != journalVersion {
+		return fmt.Errorf("journal: unsupported version %d", version)
+	}
+
+	readBytes := func() (b []byte, err error) {
+		var n uint64
+		if n, err = binary.ReadUvarint(br); err != nil {
+			return
+		}
+		if n > journalMaxField {
+			err = fmt.Errorf("field length %d too large", n)
+			return
+		}
+		b = make([]byte, n)
+		_, err = io.ReadFull(br, b)
+		return
+	}
+
+	for {
+		var kind byte
+		if kind, err = br.ReadByte(); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return
+		}
+
+		// a truncated record at the end of the journal is reported as an error:
+		record := JournalRecord{Kind: JournalRecordKind(kind)}
+		var b []byte
+		var v uint64
+		var ts int64
+		if ts, err = binary.ReadVarint(br); err != nil {
+			break
+		}
+		record.Timestamp = time.Unix(0, ts)
+		if b, err = readBytes(); err != nil {
+			break
+		}
+		record.URI = string(b)
+		if b, err = readBytes(); err != nil {
+			break
+		}
+		record.Client = string(b)
+		if v, err = binary.ReadUvarint(br); err != nil {
+			break
+		}
+		record.Address.Address = uint32(v)
+		if kind, err = br.ReadByte(); err != nil {
+			break
+		}
+		record.Address.AddressSpace = sni.AddressSpace(kind)
+		if kind, err = br.ReadByte(); err != nil {
+			break
+		}
+		record.Address.MemoryMapping = sni.MemoryMapping(kind)
+		if record.Data, err = readBytes(); err != nil {
+			break
+		}
+
+		if err = fn(record); err != nil {
+			return
+		}
+	}
+
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("journal: %w", err)
+}
@@ -0,0 +1,158 @@
+package devices
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sni/protos/sni"
+	"testing"
+	"time"
+)
+
+func TestJournal_roundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test"+journalExt)
+	j, err := CreateJournal(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := WithClient(context.Background(), "usb2snes:tracker")
+	records := []JournalRecord{
+		{
+			Kind:      JournalRead,
+			Timestamp: time.Unix(1700000000, 123),
+			URI:       "mock:",
+			Client:    ClientFromContext(ctx),
+			Address:   AddressTuple{Address: 0xF50010, AddressSpace: sni.AddressSpace_FxPakPro},
+			Data:      []byte{1, 2, 3},
+		},
+		{
+			Kind:      JournalWrite,
+			Timestamp: time.Unix(1700000001, 0),
+			URI:       "fxpakpro://./dev/ttyACM0",
+			Client:    "grpc:127.0.0.1:50000",
+			Address:   AddressTuple{Address: 0x7E0010, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM},
+			Data:      []byte{0xFF},
+		},
+	}
+	j.Record(records[0])
+	j.Record(records[1])
+	if err = j.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var got []JournalRecord
+	if err = ReadJournal(f, func(record JournalRecord) error {
+		got = append(got, record)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != len(records) {
+		t.Fatalf("read %d records, want %d", len(got), len(records))
+	}
+	for i := range records {
+		e, a := records[i], got[i]
+		if a.Kind != e.Kind || !a.Timestamp.Equal(e.Timestamp) || a.URI != e.URI || a.Client != e.Client || a.Address != e.Address || !bytes.Equal(a.Data, e.Data) {
+			t.Fatalf("record %d = %+v, want %+v", i, a, e)
+		}
+	}
+}
+
+// readRecords reads all records of the journal file at path
+func readRecords(t *testing.T, path string) (records []JournalRecord) {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err = ReadJournal(f, func(record JournalRecord) error {
+		records = append(records, record)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func TestJournal_writesOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test"+journalExt)
+	j, err := CreateJournal(path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if j.RecordsReads() {
+		t.Fatal("RecordsReads() = true for a writes only journal")
+	}
+
+	j.Record(
+		JournalRecord{Kind: JournalRead, Address: AddressTuple{Address: 0xF50000}, Data: []byte{1}},
+		JournalRecord{Kind: JournalWrite, Address: AddressTuple{Address: 0xF50001}, Data: []byte{2}},
+	)
+	j.Record(JournalRecord{Kind: JournalRead, Address: AddressTuple{Address: 0xF50002}, Data: []byte{3}})
+	if err = j.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	got := readRecords(t, path)
+	if len(got) != 1 || got[0].Kind != JournalWrite || got[0].Address.Address != 0xF50001 {
+		t.Fatalf("records = %+v, want only the write", got)
+	}
+}
+
+func TestJournal_flushesPeriodically(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test"+journalExt)
+	j, err := createJournal(path, false, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer j.Close()
+
+	data := []byte{1, 2, 3}
+	j.Record(JournalRecord{Kind: JournalWrite, Address: AddressTuple{Address: 0xF50000}, Data: data})
+	// the record is encoded by Record so the caller may reuse its data:
+	data[0] = 0xFF
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if got := readRecords(t, path); len(got) == 1 {
+			if !bytes.Equal(got[0].Data, []byte{1, 2, 3}) {
+				t.Fatalf("record data = % x, want 01 02 03", got[0].Data)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("record was not flushed before the journal was closed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// records after Close are dropped rather than blocking:
+	if err = j.Close(); err != nil {
+		t.Fatal(err)
+	}
+	j.Record(JournalRecord{Kind: JournalWrite, Data: []byte{4}})
+}
+
+func TestReadJournal_truncated(t *testing.T) {
+	var b bytes.Buffer
+	b.WriteString(journalMagic)
+	b.WriteByte(journalVersion)
+	b.WriteByte(byte(JournalWrite))
+
+	err := ReadJournal(&b, func(JournalRecord) error { return nil })
+	if err == nil {
+		t.Fatal("expected an error for a truncated record")
+	}
+}
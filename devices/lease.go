@@ -0,0 +1,144 @@
+package devices
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sync"
+	"time"
+)
+
+// DefaultLeaseTTL is used when a lease is acquired without a TTL
+const DefaultLeaseTTL = 5 * time.Second
+
+type leaseIDKeyType int
+
+var leaseIDKey leaseIDKeyType
+
+// WithLeaseID returns a context that identifies the caller as the holder of the given lease
+func WithLeaseID(ctx context.Context, leaseID string) context.Context {
+	if leaseID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, leaseIDKey, leaseID)
+}
+
+// LeaseIDFromContext returns the lease ID the caller claims to hold, if any
+func LeaseIDFromContext(ctx context.Context) string {
+	leaseID, _ := ctx.Value(leaseIDKey).(string)
+	return leaseID
+}
+
+type lease struct {
+	id        string
+	expiresAt time.Time
+	// closed when the lease is released:
+	released chan struct{}
+}
+
+func (l *lease) isExpired(now time.Time) bool {
+	return !now.Before(l.expiresAt)
+}
+
+var (
+	leasesMu sync.Mutex
+	leases   = make(map[string]*lease)
+)
+
+func newLeaseID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// acquireLease grants exclusive access to the device identified by key. If leaseID refers to the currently held
+// lease then it is renewed with the new ttl. If another lease is held and wait is true, this blocks until that
+// lease is released or expires or ctx is done; otherwise it fails with codes.Aborted.
+func acquireLease(ctx context.Context, key string, leaseID string, ttl time.Duration, wait bool) (string, time.Time, error) {
+	if ttl <= 0 {
+		ttl = DefaultLeaseTTL
+	}
+
+	for {
+		leasesMu.Lock()
+		now := time.Now()
+		l, ok := leases[key]
+		if ok && l.isExpired(now) {
+			delete(leases, key)
+			close(l.released)
+			ok = false
+		}
+
+		if !ok {
+			l = &lease{
+				id:        newLeaseID(),
+				expiresAt: now.Add(ttl),
+				released:  make(chan struct{}),
+			}
+			leases[key] = l
+			leasesMu.Unlock()
+			return l.id, l.expiresAt, nil
+		}
+
+		if leaseID != "" && l.id == leaseID {
+			// renew:
+			l.expiresAt = now.Add(ttl)
+			leasesMu.Unlock()
+			return l.id, l.expiresAt, nil
+		}
+
+		// renewals by the holder update expiresAt under the lock:
+		expiresAt := l.expiresAt
+		leasesMu.Unlock()
+		if !wait {
+			return "", time.Time{}, WithCode(codes.Aborted, fmt.Errorf("device is leased by another client"))
+		}
+
+		// wait for release or expiry:
+		timer := time.NewTimer(expiresAt.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return "", time.Time{}, WithCode(codes.DeadlineExceeded, ctx.Err())
+		case <-l.released:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+func releaseLease(key string, leaseID string) error {
+	leasesMu.Lock()
+	defer leasesMu.Unlock()
+
+	l, ok := leases[key]
+	if !ok || l.isExpired(time.Now()) {
+		// nothing to release:
+		return nil
+	}
+	if l.id != leaseID {
+		return WithCode(codes.PermissionDenied, fmt.Errorf("lease is held by another client"))
+	}
+
+	delete(leases, key)
+	close(l.released)
+	return nil
+}
+
+// checkLease fails with codes.Aborted if the device identified by key is leased to a client other than the caller
+func checkLease(ctx context.Context, key string) error {
+	leasesMu.Lock()
+	defer leasesMu.Unlock()
+
+	l, ok := leases[key]
+	if !ok || l.isExpired(time.Now()) {
+		return nil
+	}
+	if l.id == LeaseIDFromContext(ctx) {
+		return nil
+	}
+
+	return WithCode(codes.Aborted, fmt.Errorf("device is leased by another client"))
+}
@@ -0,0 +1,229 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// codeOf returns the code of a CodedError or codes.OK for nil
+func codeOf(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	var coded *CodedError
+	if errors.As(err, &coded) {
+		return coded.Code
+	}
+	return codes.Unknown
+}
+
+func TestAcquireLease(t *testing.T) {
+	tests := []struct {
+		name string
+		// heldTTL is the TTL of a lease held before acquiring; none if 0:
+		heldTTL time.Duration
+		// releaseAfter releases the held lease after the given time; never if 0:
+		releaseAfter time.Duration
+		// renew presents the held lease ID:
+		renew bool
+		// leaseID is presented if not renewing:
+		leaseID    string
+		ttl        time.Duration
+		wait       bool
+		ctxTimeout time.Duration
+
+		wantCode   codes.Code
+		wantSameID bool
+		wantTTL    time.Duration
+		// wantWait is the least time acquiring should block for:
+		wantWait time.Duration
+	}{
+		{name: "free", ttl: time.Minute, wantTTL: time.Minute},
+		{name: "default ttl", wantTTL: DefaultLeaseTTL},
+		{name: "renew", heldTTL: time.Second, renew: true, ttl: time.Minute, wantSameID: true, wantTTL: time.Minute},
+		{name: "held by another", heldTTL: time.Minute, wantCode: codes.Aborted},
+		{name: "held by another with unknown id", heldTTL: time.Minute, leaseID: "unknown", wantCode: codes.Aborted},
+		{name: "expired", heldTTL: time.Nanosecond, ttl: time.Minute, wantTTL: time.Minute},
+		{name: "wait for expiry", heldTTL: 50 * time.Millisecond, wait: true, ttl: time.Minute, wantTTL: time.Minute, wantWait: 40 * time.Millisecond},
+		{name: "wait for release", heldTTL: time.Hour, releaseAfter: 20 * time.Millisecond, wait: true, ttl: time.Minute, wantTTL: time.Minute, wantWait: 20 * time.Millisecond},
+		{name: "wait cancelled", heldTTL: time.Hour, wait: true, ctxTimeout: 20 * time.Millisecond, wantCode: codes.DeadlineExceeded, wantWait: 20 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "test:" + t.Name()
+			t.Cleanup(func() {
+				leasesMu.Lock()
+				delete(leases, key)
+				leasesMu.Unlock()
+			})
+
+			var heldID string
+			if tt.heldTTL > 0 {
+				var err error
+				if heldID, _, err = acquireLease(context.Background(), key, "", tt.heldTTL, false); err != nil {
+					t.Fatal(err)
+				}
+				time.Sleep(time.Millisecond)
+			}
+			if tt.releaseAfter > 0 {
+				timer := time.AfterFunc(tt.releaseAfter, func() { _ = releaseLease(key, heldID) })
+				defer timer.Stop()
+			}
+
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			leaseID := tt.leaseID
+			if tt.renew {
+				leaseID = heldID
+			}
+			start := time.Now()
+			id, expiresAt, err := acquireLease(ctx, key, leaseID, tt.ttl, tt.wait)
+			waited := time.Since(start)
+
+			if code := codeOf(err); code != tt.wantCode {
+				t.Fatalf("acquireLease() error = %v, want code %v", err, tt.wantCode)
+			}
+			if waited < tt.wantWait {
+				t.Errorf("acquireLease() returned after %v, want at least %v", waited, tt.wantWait)
+			}
+			if err != nil {
+				return
+			}
+
+			if id == "" || (id == heldID) != tt.wantSameID {
+				t.Errorf("acquireLease() id = %q, held %q, want same %v", id, heldID, tt.wantSameID)
+			}
+			// the lease is granted some time between calling and returning:
+			if expiresAt.Before(start.Add(tt.wantTTL)) || expiresAt.After(start.Add(waited+tt.wantTTL)) {
+				t.Errorf("acquireLease() expires in %v, want %v", expiresAt.Sub(start), tt.wantTTL)
+			}
+		})
+	}
+}
+
+func TestReleaseLease(t *testing.T) {
+	tests := []struct {
+		name    string
+		heldTTL time.Duration
+		// release presents the held lease ID, otherwise leaseID:
+		release  bool
+		leaseID  string
+		wantCode codes.Code
+		// wantHeld is whether another client is still locked out after releasing:
+		wantHeld bool
+	}{
+		{name: "not held", leaseID: "unknown"},
+		{name: "held", heldTTL: time.Minute, release: true},
+		{name: "held by another", heldTTL: time.Minute, leaseID: "unknown", wantCode: codes.PermissionDenied, wantHeld: true},
+		{name: "expired", heldTTL: time.Nanosecond, leaseID: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "test:" + t.Name()
+			t.Cleanup(func() {
+				leasesMu.Lock()
+				delete(leases, key)
+				leasesMu.Unlock()
+			})
+
+			var heldID string
+			if tt.heldTTL > 0 {
+				var err error
+				if heldID, _, err = acquireLease(context.Background(), key, "", tt.heldTTL, false); err != nil {
+					t.Fatal(err)
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			leaseID := tt.leaseID
+			if tt.release {
+				leaseID = heldID
+			}
+			if code := codeOf(releaseLease(key, leaseID)); code != tt.wantCode {
+				t.Fatalf("releaseLease() code = %v, want %v", code, tt.wantCode)
+			}
+
+			err := checkLease(context.Background(), key)
+			if held := codeOf(err) == codes.Aborted; held != tt.wantHeld {
+				t.Fatalf("checkLease() after release = %v, want held %v", err, tt.wantHeld)
+			}
+		})
+	}
+}
+
+func TestCheckLease(t *testing.T) {
+	key := "test:" + t.Name()
+
+	if err := checkLease(context.Background(), key); err != nil {
+		t.Fatalf("checkLease() without lease = %v", err)
+	}
+
+	heldID, _, err := acquireLease(context.Background(), key, "", time.Minute, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = releaseLease(key, heldID) }()
+
+	if err = checkLease(WithLeaseID(context.Background(), heldID), key); err != nil {
+		t.Fatalf("checkLease() by holder = %v", err)
+	}
+	if code := codeOf(checkLease(WithLeaseID(context.Background(), "other"), key)); code != codes.Aborted {
+		t.Fatalf("checkLease() by another client = %v, want %v", code, codes.Aborted)
+	}
+}
+
+func TestAutoCloseableDevice_leased(t *testing.T) {
+	// the device cannot be opened so only calls that check the lease first fail with codes.Aborted:
+	container := NewDeviceDriverContainer(func(uri *url.URL) (Device, error) {
+		return nil, fmt.Errorf("not opened")
+	})
+	a := NewAutoCloseableDevice(container, &url.URL{Scheme: "test", Opaque: t.Name()}, t.Name())
+
+	holder := context.Background()
+	leaseID, _, err := a.AcquireLease(holder, "", time.Minute, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = a.ReleaseLease(holder, leaseID) }()
+
+	ctx := WithLeaseID(context.Background(), "other")
+	calls := map[string]func() error{
+		"MultiWriteMemory": func() error { _, err := a.MultiWriteMemory(ctx); return err },
+		"CompareAndWriteMemory": func() error {
+			_, err := a.CompareAndWriteMemory(ctx, MemoryCompareAndWriteRequest{})
+			return err
+		},
+		"ExecuteASM":       func() error { _, _, err := a.ExecuteASM(ctx, nil, false); return err },
+		"ResetSystem":      func() error { return a.ResetSystem(ctx) },
+		"ResetToMenu":      func() error { return a.ResetToMenu(ctx) },
+		"PauseUnpause":     func() error { _, err := a.PauseUnpause(ctx, true); return err },
+		"PauseToggle":      func() error { return a.PauseToggle(ctx) },
+		"FrameAdvance":     func() error { return a.FrameAdvance(ctx, 1) },
+		"PowerCycle":       func() error { return a.PowerCycle(ctx) },
+		"SetRealTimeClock": func() error { return a.SetRealTimeClock(ctx, time.Now()) },
+		"SaveState":        func() error { return a.SaveState(ctx, 1) },
+		"LoadState":        func() error { return a.LoadState(ctx, 1) },
+		"MakeDirectory":    func() error { return a.MakeDirectory(ctx, "/dir") },
+		"RemoveFile":       func() error { return a.RemoveFile(ctx, "/file") },
+		"RenameFile":       func() error { return a.RenameFile(ctx, "/file", "new") },
+		"PutFile":          func() error { _, err := a.PutFile(ctx, "/file", 0, nil, nil); return err },
+		"BootFile":         func() error { return a.BootFile(ctx, "/file") },
+		"NWACommand":       func() error { _, _, err := a.NWACommand(ctx, "EMULATION_PAUSE", "", nil); return err },
+	}
+	for name, call := range calls {
+		if err := call(); codeOf(err) != codes.Aborted {
+			t.Errorf("%s() by another client = %v, want %v", name, err, codes.Aborted)
+		}
+	}
+}
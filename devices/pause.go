@@ -0,0 +1,43 @@
+package devices
+
+import (
+	"context"
+	"log"
+	"sni/protos/sni"
+	"strings"
+)
+
+// PauseEmulation pauses emulation for an operation which must not race the running game and returns resume to
+// unpause it afterwards. resume only unpauses if PauseEmulation did the pausing, so emulation the user paused stays
+// paused. Emulators which do not report whether they are paused are paused and unpaused again regardless, which
+// unpauses them even if the user paused them. Emulators which fail to pause are left running.
+func PauseEmulation(
+	ctx context.Context,
+	device interface {
+		DeviceControl
+		FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error)
+	},
+) (resume func() error) {
+	resume = func() error { return nil }
+
+	var status string
+	if values, err := device.FetchFields(ctx, sni.Field_DeviceStatus); err != nil {
+		log.Printf("pause: unable to read emulation status: %v\n", err)
+		return
+	} else if len(values) > 0 {
+		status = values[0]
+	}
+	if strings.EqualFold(status, "paused") {
+		return
+	}
+
+	if _, err := device.PauseUnpause(ctx, true); err != nil {
+		log.Printf("pause: unable to pause emulation; continuing unpaused: %v\n", err)
+		return
+	}
+
+	return func() (err error) {
+		_, err = device.PauseUnpause(ctx, false)
+		return
+	}
+}
@@ -0,0 +1,391 @@
+package devices
+
+import (
+	"context"
+	"sni/cmd/sni/config"
+	"sni/protos/sni"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// readFunc performs reads against the device
+type readFunc func(ctx context.Context, reads []MemoryReadRequest) ([]MemoryReadResponse, error)
+
+// pendingRead is a caller's MultiReadMemory waiting to be merged into a batch
+type pendingRead struct {
+	ctx   context.Context
+	reads []MemoryReadRequest
+	do    readFunc
+
+	rsp  []MemoryReadResponse
+	err  error
+	done chan struct{}
+}
+
+// readScheduler merges the reads of all concurrent callers of a device into as few device reads as possible and
+// optionally caches the results for a short time.
+//
+// A batch is started as soon as a read arrives and the device is idle; reads arriving while a batch is executing
+// are merged into the next batch. With a non-zero window the scheduler also waits that long before starting a
+// batch to collect more reads.
+type readScheduler struct {
+	window time.Duration
+	maxAge time.Duration
+
+	lock    sync.Mutex
+	pending []*pendingRead
+	busy    bool
+
+	// cache holds the merged responses of recent batches when maxAge is non-zero:
+	cache   []cachedRead
+	cacheAt time.Time
+	// generation is incremented on invalidation so that batches in flight do not cache stale data:
+	generation uint64
+}
+
+type cachedRead struct {
+	MemoryReadResponse
+	size int
+}
+
+var (
+	readSchedulersMu sync.Mutex
+	readSchedulers   = make(map[string]*readScheduler)
+)
+
+// readSchedulerFor returns the read scheduler shared by all users of the device identified by key
+func readSchedulerFor(key string) *readScheduler {
+	readSchedulersMu.Lock()
+	defer readSchedulersMu.Unlock()
+
+	s, ok := readSchedulers[key]
+	if !ok {
+		s = &readScheduler{
+			window: config.Config.GetDuration("read_coalesce_window"),
+			maxAge: config.Config.GetDuration("read_cache_max_age"),
+		}
+		readSchedulers[key] = s
+	}
+	return s
+}
+
+// read schedules the reads and waits for their responses. do is called to perform the merged reads.
+func (s *readScheduler) read(ctx context.Context, reads []MemoryReadRequest, do readFunc) ([]MemoryReadResponse, error) {
+	if len(reads) == 0 {
+		return do(ctx, reads)
+	}
+
+	s.lock.Lock()
+	if rsp, ok := s.fromCache(reads); ok {
+		s.lock.Unlock()
+		return rsp, nil
+	}
+
+	p := &pendingRead{ctx: ctx, reads: reads, do: do, done: make(chan struct{})}
+	s.pending = append(s.pending, p)
+	if !s.busy {
+		s.busy = true
+		go s.run()
+	}
+	s.lock.Unlock()
+
+	select {
+	case <-p.done:
+		return p.rsp, p.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// invalidate drops all cached reads; it must be called after anything that may modify device memory
+func (s *readScheduler) invalidate() {
+	s.lock.Lock()
+	s.cache = nil
+	s.generation++
+	s.lock.Unlock()
+}
+
+// run executes batches until no reads are pending
+func (s *readScheduler) run() {
+	for {
+		if s.window > 0 {
+			time.Sleep(s.window)
+		}
+
+		s.lock.Lock()
+		batch := s.pending
+		s.pending = nil
+		if len(batch) == 0 {
+			s.busy = false
+			s.lock.Unlock()
+			return
+		}
+		generation := s.generation
+		s.lock.Unlock()
+
+		merged, rsp, err := s.execute(batch)
+
+		s.lock.Lock()
+		if err == nil && s.maxAge > 0 && generation == s.generation {
+			s.cache = s.cache[:0]
+			for i := range rsp {
+				s.cache = append(s.cache, cachedRead{MemoryReadResponse: rsp[i], size: merged[i].Size})
+			}
+			s.cacheAt = time.Now()
+		}
+		s.lock.Unlock()
+	}
+}
+
+// execute performs the merged reads of a batch and splits the responses back to each caller
+func (s *readScheduler) execute(batch []*pendingRead) (merged []MemoryReadRequest, rsp []MemoryReadResponse, err error) {
+	var all []MemoryReadRequest
+	for _, p := range batch {
+		all = append(all, p.reads...)
+	}
+	merged = mergeReads(all)
+
+	ctx, cancel := batchContext(batch)
+	rsp, err = batch[0].do(ctx, merged)
+	cancel()
+	if err == nil && len(rsp) != len(merged) {
+		err = DeviceNonFatal("read scheduler: device returned an unexpected number of responses", nil)
+	}
+
+	if err != nil && len(batch) > 1 && !IsFatal(err) {
+		// one caller's bad read should not fail the others so retry each caller's reads on their own:
+		for _, p := range batch {
+			p.rsp, p.err = p.do(p.ctx, p.reads)
+			close(p.done)
+		}
+		return
+	}
+
+	for i := range rsp {
+		rsp[i].RequestAddress = merged[i].RequestAddress
+	}
+	for _, p := range batch {
+		if err != nil {
+			p.err = err
+		} else {
+			p.rsp = splitReads(p.reads, merged, rsp)
+		}
+		close(p.done)
+	}
+	return
+}
+
+// batchContext returns a context for a batch which carries the first caller's values but is not canceled when any
+// single caller gives up. It is canceled once all callers gave up, and its deadline is the latest of the callers'
+// deadlines if they all have one.
+func batchContext(batch []*pendingRead) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(batch[0].ctx))
+
+	var latest time.Time
+	for _, p := range batch {
+		deadline, ok := p.ctx.Deadline()
+		if !ok {
+			latest = time.Time{}
+			break
+		}
+		if deadline.After(latest) {
+			latest = deadline
+		}
+	}
+	cancelDeadline := context.CancelFunc(func() {})
+	if !latest.IsZero() {
+		ctx, cancelDeadline = context.WithDeadline(ctx, latest)
+	}
+
+	remaining := int32(len(batch))
+	stops := make([]func() bool, 0, len(batch))
+	for _, p := range batch {
+		stops = append(stops, context.AfterFunc(p.ctx, func() {
+			if atomic.AddInt32(&remaining, -1) == 0 {
+				cancel()
+			}
+		}))
+	}
+
+	return ctx, func() {
+		for _, stop := range stops {
+			stop()
+		}
+		cancelDeadline()
+		cancel()
+	}
+}
+
+// fromCache returns responses for all reads if they are covered by cached reads which are not too old
+func (s *readScheduler) fromCache(reads []MemoryReadRequest) (rsp []MemoryReadResponse, ok bool) {
+	if s.maxAge <= 0 || len(s.cache) == 0 || time.Since(s.cacheAt) > s.maxAge {
+		return nil, false
+	}
+
+	rsp = make([]MemoryReadResponse, 0, len(reads))
+	for _, r := range reads {
+		found := false
+		for i := range s.cache {
+			c := &s.cache[i]
+			if !contains(c.RequestAddress, c.size, r) {
+				continue
+			}
+			rsp = append(rsp, sliceResponse(r, &c.MemoryReadResponse))
+			found = true
+			break
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return rsp, true
+}
+
+// mergeable reports whether the address spaces and mappings of a and b are the same so that their ranges can be
+// compared
+func mergeable(a, b AddressTuple) bool {
+	return a.AddressSpace == b.AddressSpace && a.MemoryMapping == b.MemoryMapping
+}
+
+// fxPakProBoundaries are the starts of the memory types in the FX Pak Pro address space, as classified by
+// mapping.MemoryTypeForPakAddressWithMapping. Emulators translate a read to a memory domain by its start address so
+// a merged read must not run from one memory type into the next.
+var fxPakProBoundaries = []uint64{
+	0xE00000, // SRAM
+	0xF00000, // unmapped
+	0xF50000, // WRAM
+	0xF70000, // VRAM
+	0xF80000, // APU
+	0xF90000, // CGRAM
+	0xF90200, // OAM
+	0xF90420, // MISC
+	0xF90500, // PPUREG
+	0xF90700, // CPUREG
+	0xF90900, // unmapped
+}
+
+// bsxBoundaries are the additional memory type boundaries of the BS-X mapping: memory pack, unmapped and PSRAM
+var bsxBoundaries = []uint64{0x100000, 0x400000, 0x480000}
+
+// snesABusSystemBoundaries are the starts of the regions within the system banks $00-$3F and $80-$BF: the I/O
+// registers follow the WRAM mirror at $0000 and the expansion area, where HiROM maps SRAM, follows the I/O registers.
+// ROM at $8000 starts the next 32KiB page.
+var snesABusSystemBoundaries = []uint64{0x2000, 0x6000}
+
+// page returns the range a read must not cross when merged. SNES A-bus addresses are only merged within a 32KiB
+// page, and within a region of the system banks, since adjacent pages or regions may map to unrelated memory or I/O
+// registers. FX Pak Pro addresses are only merged within a memory type.
+func page(a AddressTuple) (start, end uint64) {
+	addr := uint64(a.Address)
+	bound := func(boundary uint64) {
+		if boundary <= addr && boundary > start {
+			start = boundary
+		} else if boundary > addr && boundary < end {
+			end = boundary
+		}
+	}
+
+	switch a.AddressSpace {
+	case sni.AddressSpace_SnesABus:
+		start = addr &^ 0x7FFF
+		end = start + 0x8000
+		if bank := addr >> 16; bank&0x7F < 0x40 {
+			for _, boundary := range snesABusSystemBoundaries {
+				bound(bank<<16 | boundary)
+			}
+		}
+		return
+	case sni.AddressSpace_FxPakPro:
+		start, end = 0, 1<<32
+		for _, boundary := range fxPakProBoundaries {
+			bound(boundary)
+		}
+		if a.MemoryMapping == sni.MemoryMapping_BSX {
+			for _, boundary := range bsxBoundaries {
+				bound(boundary)
+			}
+		}
+		return
+	}
+	return 0, 1 << 32
+}
+
+func contains(merged AddressTuple, size int, r MemoryReadRequest) bool {
+	if !mergeable(merged, r.RequestAddress) {
+		return false
+	}
+	start := uint64(merged.Address)
+	return uint64(r.RequestAddress.Address) >= start &&
+		uint64(r.RequestAddress.Address)+uint64(r.Size) <= start+uint64(size)
+}
+
+// mergeReads merges overlapping and adjacent reads into the fewest reads that cover them
+func mergeReads(reads []MemoryReadRequest) (merged []MemoryReadRequest) {
+	sorted := append([]MemoryReadRequest(nil), reads...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].RequestAddress, sorted[j].RequestAddress
+		if a.AddressSpace != b.AddressSpace {
+			return a.AddressSpace < b.AddressSpace
+		}
+		if a.MemoryMapping != b.MemoryMapping {
+			return a.MemoryMapping < b.MemoryMapping
+		}
+		return a.Address < b.Address
+	})
+
+	for _, r := range sorted {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			start := uint64(last.RequestAddress.Address)
+			end := start + uint64(last.Size)
+			_, pageEnd := page(last.RequestAddress)
+			rStart := uint64(r.RequestAddress.Address)
+			rEnd := rStart + uint64(r.Size)
+			if mergeable(last.RequestAddress, r.RequestAddress) && rStart <= end && rEnd <= pageEnd {
+				if rEnd > end {
+					last.Size = int(rEnd - start)
+				}
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return
+}
+
+// sliceResponse returns the part of the merged response covering the read r
+func sliceResponse(r MemoryReadRequest, m *MemoryReadResponse) MemoryReadResponse {
+	offset := int(r.RequestAddress.Address - m.RequestAddress.Address)
+	data := m.Data
+	if offset > len(data) {
+		offset = len(data)
+	}
+	end := offset + r.Size
+	if end > len(data) {
+		end = len(data)
+	}
+
+	deviceAddress := m.DeviceAddress
+	deviceAddress.Address += uint32(offset)
+	return MemoryReadResponse{
+		RequestAddress: r.RequestAddress,
+		DeviceAddress:  deviceAddress,
+		Data:           append([]byte(nil), data[offset:end]...),
+	}
+}
+
+// splitReads returns the responses to reads from the responses to the merged reads
+func splitReads(reads []MemoryReadRequest, merged []MemoryReadRequest, rsp []MemoryReadResponse) []MemoryReadResponse {
+	out := make([]MemoryReadResponse, 0, len(reads))
+	for _, r := range reads {
+		for j := range merged {
+			if contains(merged[j].RequestAddress, merged[j].Size, r) {
+				out = append(out, sliceResponse(r, &rsp[j]))
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,360 @@
+package devices
+
+import (
+	"bytes"
+	"context"
+	"sni/protos/sni"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeMemory serves reads from a byte slice indexed by address and records the reads it was asked to perform
+type fakeMemory struct {
+	lock  sync.Mutex
+	mem   []byte
+	calls [][]MemoryReadRequest
+	// gate, if non-nil, blocks reads until it is closed
+	gate chan struct{}
+}
+
+func (f *fakeMemory) read(ctx context.Context, reads []MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	if f.gate != nil {
+		<-f.gate
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.calls = append(f.calls, reads)
+	for _, r := range reads {
+		a := r.RequestAddress.Address
+		if int(a)+r.Size > len(f.mem) {
+			return nil, DeviceNonFatal("out of range", nil)
+		}
+		rsp = append(rsp, MemoryReadResponse{
+			RequestAddress: r.RequestAddress,
+			DeviceAddress:  r.RequestAddress,
+			Data:           append([]byte(nil), f.mem[a:int(a)+r.Size]...),
+		})
+	}
+	return
+}
+
+func fxpakRead(address uint32, size int) MemoryReadRequest {
+	return MemoryReadRequest{
+		RequestAddress: AddressTuple{Address: address, AddressSpace: sni.AddressSpace_FxPakPro},
+		Size:           size,
+	}
+}
+
+func newFakeMemory() *fakeMemory {
+	f := &fakeMemory{mem: make([]byte, 0x1000)}
+	for i := range f.mem {
+		f.mem[i] = byte(i)
+	}
+	return f
+}
+
+// holdScheduler blocks the scheduler on a read until the returned func is called so that reads issued meanwhile are
+// merged into one batch
+func holdScheduler(t *testing.T, s *readScheduler, f *fakeMemory) (release func()) {
+	t.Helper()
+
+	f.gate = make(chan struct{})
+	first := make(chan struct{})
+	go func() {
+		defer close(first)
+		_, _ = s.read(context.Background(), []MemoryReadRequest{fxpakRead(0, 1)}, f.read)
+	}()
+	waitPending(s, 0)
+
+	return func() {
+		close(f.gate)
+		<-first
+	}
+}
+
+// waitPending waits until the scheduler is busy with n reads pending
+func waitPending(s *readScheduler, n int) {
+	for {
+		s.lock.Lock()
+		done := s.busy && len(s.pending) == n
+		s.lock.Unlock()
+		if done {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestMergeReads(t *testing.T) {
+	merged := mergeReads([]MemoryReadRequest{
+		fxpakRead(0x110, 0x10),
+		fxpakRead(0x100, 0x10),
+		fxpakRead(0x108, 0x04),
+		fxpakRead(0x200, 0x02),
+		{RequestAddress: AddressTuple{Address: 0x7FFE, AddressSpace: sni.AddressSpace_SnesABus}, Size: 2},
+		{RequestAddress: AddressTuple{Address: 0x8000, AddressSpace: sni.AddressSpace_SnesABus}, Size: 2},
+	})
+
+	want := []MemoryReadRequest{
+		fxpakRead(0x100, 0x20),
+		fxpakRead(0x200, 0x02),
+		{RequestAddress: AddressTuple{Address: 0x7FFE, AddressSpace: sni.AddressSpace_SnesABus}, Size: 2},
+		{RequestAddress: AddressTuple{Address: 0x8000, AddressSpace: sni.AddressSpace_SnesABus}, Size: 2},
+	}
+	if len(merged) != len(want) {
+		t.Fatalf("mergeReads() = %+v, want %+v", merged, want)
+	}
+	for i := range want {
+		if merged[i] != want[i] {
+			t.Errorf("merged[%d] = %+v, want %+v", i, merged[i], want[i])
+		}
+	}
+}
+
+func TestMergeReads_memoryTypeBoundary(t *testing.T) {
+	bsxRead := func(address uint32, size int) MemoryReadRequest {
+		r := fxpakRead(address, size)
+		r.RequestAddress.MemoryMapping = sni.MemoryMapping_BSX
+		return r
+	}
+
+	merged := mergeReads([]MemoryReadRequest{
+		// WRAM and VRAM are adjacent but separate memory types:
+		fxpakRead(0xF6FFF0, 0x10),
+		fxpakRead(0xF70000, 0x10),
+		// reads within SRAM are still merged:
+		fxpakRead(0xE00000, 0x10),
+		fxpakRead(0xE00010, 0x10),
+		// the BS-X memory pack ends where the unmapped region starts:
+		bsxRead(0x0FFFF0, 0x10),
+		bsxRead(0x100000, 0x10),
+	})
+
+	want := []MemoryReadRequest{
+		fxpakRead(0xE00000, 0x20),
+		fxpakRead(0xF6FFF0, 0x10),
+		fxpakRead(0xF70000, 0x10),
+		bsxRead(0x0FFFF0, 0x10),
+		bsxRead(0x100000, 0x10),
+	}
+	if len(merged) != len(want) {
+		t.Fatalf("mergeReads() = %+v, want %+v", merged, want)
+	}
+	for i := range want {
+		if merged[i] != want[i] {
+			t.Errorf("merged[%d] = %+v, want %+v", i, merged[i], want[i])
+		}
+	}
+}
+
+func TestMergeReads_snesABusRegions(t *testing.T) {
+	abusRead := func(address uint32, size int) MemoryReadRequest {
+		return MemoryReadRequest{
+			RequestAddress: AddressTuple{Address: address, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM},
+			Size:           size,
+		}
+	}
+
+	merged := mergeReads([]MemoryReadRequest{
+		// the WRAM mirror of bank $00 ends where the I/O registers start:
+		abusRead(0x001FF0, 0x10),
+		abusRead(0x002000, 0x10),
+		// as does the mirror of bank $80:
+		abusRead(0x801FF8, 0x08),
+		abusRead(0x802000, 0x08),
+		// the expansion area follows the I/O registers:
+		abusRead(0x205FF8, 0x08),
+		abusRead(0x206000, 0x08),
+		// WRAM bank $7E has no such regions:
+		abusRead(0x7E1FF0, 0x10),
+		abusRead(0x7E2000, 0x10),
+		// reads within the WRAM mirror are still merged:
+		abusRead(0x000010, 0x10),
+		abusRead(0x000020, 0x10),
+	})
+
+	want := []MemoryReadRequest{
+		abusRead(0x000010, 0x20),
+		abusRead(0x001FF0, 0x10),
+		abusRead(0x002000, 0x10),
+		abusRead(0x205FF8, 0x08),
+		abusRead(0x206000, 0x08),
+		abusRead(0x7E1FF0, 0x20),
+		abusRead(0x801FF8, 0x08),
+		abusRead(0x802000, 0x08),
+	}
+	if len(merged) != len(want) {
+		t.Fatalf("mergeReads() = %+v, want %+v", merged, want)
+	}
+	for i := range want {
+		if merged[i] != want[i] {
+			t.Errorf("merged[%d] = %+v, want %+v", i, merged[i], want[i])
+		}
+	}
+}
+
+func TestBatchContext(t *testing.T) {
+	now := time.Now()
+	withDeadline := func(deadline time.Time) *pendingRead {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		t.Cleanup(cancel)
+		return &pendingRead{ctx: ctx}
+	}
+
+	// the latest deadline applies if all callers have one:
+	ctx, cancel := batchContext([]*pendingRead{withDeadline(now.Add(time.Minute)), withDeadline(now.Add(time.Hour))})
+	if deadline, ok := ctx.Deadline(); !ok || !deadline.Equal(now.Add(time.Hour)) {
+		t.Errorf("Deadline() = %v, %v, want %v", deadline, ok, now.Add(time.Hour))
+	}
+	cancel()
+
+	// a caller without a deadline removes the deadline but giving up still cancels the batch:
+	first, cancelFirst := context.WithCancel(context.Background())
+	defer cancelFirst()
+	second, cancelSecond := context.WithCancel(context.Background())
+	defer cancelSecond()
+	ctx, cancel = batchContext([]*pendingRead{{ctx: first}, withDeadline(now.Add(time.Hour)), {ctx: second}})
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("batch has a deadline although a caller has none")
+	}
+
+	cancelFirst()
+	select {
+	case <-ctx.Done():
+		t.Fatal("batch canceled when one caller gave up")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	cancelSecond()
+	select {
+	case <-ctx.Done():
+		t.Fatal("batch canceled while a caller is still waiting")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestBatchContext_allCallersGaveUp(t *testing.T) {
+	first, cancelFirst := context.WithCancel(context.Background())
+	second, cancelSecond := context.WithCancel(context.Background())
+	ctx, cancel := batchContext([]*pendingRead{{ctx: first}, {ctx: second}})
+	defer cancel()
+
+	cancelFirst()
+	cancelSecond()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("batch not canceled after all callers gave up")
+	}
+}
+
+func TestReadScheduler_coalesce(t *testing.T) {
+	f := newFakeMemory()
+	s := &readScheduler{}
+	ctx := context.Background()
+
+	// hold up the first batch so that the following callers are merged into the second:
+	release := holdScheduler(t, s, f)
+
+	var wg sync.WaitGroup
+	results := make([][]MemoryReadResponse, 3)
+	requests := [][]MemoryReadRequest{
+		{fxpakRead(0x100, 0x10)},
+		{fxpakRead(0x108, 0x10), fxpakRead(0x300, 4)},
+		{fxpakRead(0x118, 0x08)},
+	}
+	for i := range requests {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			if results[i], err = s.read(ctx, requests[i], f.read); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	waitPending(s, len(requests))
+	release()
+	wg.Wait()
+
+	if len(f.calls) != 2 {
+		t.Fatalf("device reads = %d, want 2", len(f.calls))
+	}
+	if second := f.calls[1]; len(second) != 2 || second[0] != fxpakRead(0x100, 0x20) || second[1] != fxpakRead(0x300, 4) {
+		t.Fatalf("merged reads = %+v", second)
+	}
+	for i, reads := range requests {
+		for j, r := range reads {
+			rsp := results[i][j]
+			a := r.RequestAddress.Address
+			if rsp.RequestAddress != r.RequestAddress || rsp.DeviceAddress.Address != a || !bytes.Equal(rsp.Data, f.mem[a:int(a)+r.Size]) {
+				t.Errorf("response %d/%d = %+v", i, j, rsp)
+			}
+		}
+	}
+}
+
+func TestReadScheduler_cache(t *testing.T) {
+	f := newFakeMemory()
+	s := &readScheduler{maxAge: time.Hour}
+	ctx := context.Background()
+
+	if _, err := s.read(ctx, []MemoryReadRequest{fxpakRead(0x100, 0x10)}, f.read); err != nil {
+		t.Fatal(err)
+	}
+	// wait for the batch to be cached:
+	for {
+		s.lock.Lock()
+		busy := s.busy
+		s.lock.Unlock()
+		if !busy {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	rsp, err := s.read(ctx, []MemoryReadRequest{fxpakRead(0x104, 4)}, f.read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.calls) != 1 || !bytes.Equal(rsp[0].Data, []byte{4, 5, 6, 7}) {
+		t.Fatalf("cached read = %+v after %d device reads", rsp, len(f.calls))
+	}
+
+	s.invalidate()
+	if _, err = s.read(ctx, []MemoryReadRequest{fxpakRead(0x104, 4)}, f.read); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.calls) != 2 {
+		t.Fatalf("device reads = %d after invalidate, want 2", len(f.calls))
+	}
+}
+
+func TestReadScheduler_failureIsolated(t *testing.T) {
+	f := newFakeMemory()
+	s := &readScheduler{}
+	ctx := context.Background()
+	release := holdScheduler(t, s, f)
+
+	var wg sync.WaitGroup
+	var goodErr, badErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, goodErr = s.read(ctx, []MemoryReadRequest{fxpakRead(0x100, 4)}, f.read)
+	}()
+	go func() {
+		defer wg.Done()
+		_, badErr = s.read(ctx, []MemoryReadRequest{fxpakRead(0x2000, 4)}, f.read)
+	}()
+	waitPending(s, 2)
+	release()
+	wg.Wait()
+
+	if goodErr != nil || badErr == nil {
+		t.Fatalf("good read error = %v, bad read error = %v", goodErr, badErr)
+	}
+}
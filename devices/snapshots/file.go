@@ -0,0 +1,174 @@
+package snapshots
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"io"
+	"os"
+	"sni/devices"
+)
+
+// Snapshot files are laid out as:
+//
+//   - magic: "SNIS"
+//   - version: 1 byte, fileVersion
+//   - the rest of the file is gzip compressed:
+//   - info length: 4 byte big-endian
+//   - info: JSON encoded Info
+//   - data: the contents of each region in the order of Info.Regions
+const (
+	fileMagic   = "SNIS"
+	fileVersion = 1
+	fileExt     = ".snis"
+
+	// maxInfoSize guards against allocating for a corrupt info length:
+	maxInfoSize = 1 << 20
+)
+
+func writeFile(filePath string, snapshot *Snapshot) (err error) {
+	var info []byte
+	if info, err = json.Marshal(&snapshot.Info); err != nil {
+		return
+	}
+
+	// write to a temporary file first so that a failed write does not clobber an existing snapshot:
+	tmpPath := filePath + ".tmp"
+	var f *os.File
+	if f, err = os.Create(tmpPath); err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(tmpPath)
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	if _, err = w.WriteString(fileMagic); err != nil {
+		return
+	}
+	if err = w.WriteByte(fileVersion); err != nil {
+		return
+	}
+
+	z := gzip.NewWriter(w)
+	if err = binary.Write(z, binary.BigEndian, uint32(len(info))); err != nil {
+		return
+	}
+	if _, err = z.Write(info); err != nil {
+		return
+	}
+	for _, data := range snapshot.Data {
+		if _, err = z.Write(data); err != nil {
+			return
+		}
+	}
+	if err = z.Close(); err != nil {
+		return
+	}
+	if err = w.Flush(); err != nil {
+		return
+	}
+	if err = f.Close(); err != nil {
+		return
+	}
+
+	err = os.Rename(tmpPath, filePath)
+	return
+}
+
+// openFile opens a snapshot file, checks its header and returns a reader positioned at the data
+func openFile(filePath string) (f *os.File, z *gzip.Reader, info Info, err error) {
+	if f, err = os.Open(filePath); err != nil {
+		if os.IsNotExist(err) {
+			err = devices.WithCode(codes.NotFound, fmt.Errorf("snapshots: %s not found", filePath))
+		}
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			f = nil
+		}
+	}()
+
+	header := make([]byte, len(fileMagic)+1)
+	if _, err = io.ReadFull(f, header); err != nil {
+		err = fmt.Errorf("snapshots: %s: %w", filePath, err)
+		return
+	}
+	if string(header[:len(fileMagic)]) != fileMagic {
+		err = fmt.Errorf("snapshots: %s is not a snapshot file", filePath)
+		return
+	}
+	if version := header[len(fileMagic)]; version != fileVersion {
+		err = devices.WithCode(
+			codes.FailedPrecondition,
+			fmt.Errorf("snapshots: %s has unsupported version %d", filePath, version),
+		)
+		return
+	}
+
+	if z, err = gzip.NewReader(bufio.NewReader(f)); err != nil {
+		err = fmt.Errorf("snapshots: %s: %w", filePath, err)
+		return
+	}
+
+	var size uint32
+	if err = binary.Read(z, binary.BigEndian, &size); err != nil {
+		err = fmt.Errorf("snapshots: %s: %w", filePath, err)
+		return
+	}
+	if size > maxInfoSize {
+		err = fmt.Errorf("snapshots: %s: info too large", filePath)
+		return
+	}
+	b := make([]byte, size)
+	if _, err = io.ReadFull(z, b); err != nil {
+		err = fmt.Errorf("snapshots: %s: %w", filePath, err)
+		return
+	}
+	if err = json.Unmarshal(b, &info); err != nil {
+		err = fmt.Errorf("snapshots: %s: %w", filePath, err)
+		return
+	}
+
+	return
+}
+
+func readInfo(filePath string) (info Info, err error) {
+	var f *os.File
+	if f, _, info, err = openFile(filePath); err != nil {
+		return
+	}
+	_ = f.Close()
+	return
+}
+
+func readFile(filePath string) (snapshot *Snapshot, err error) {
+	var f *os.File
+	var z *gzip.Reader
+	var info Info
+	if f, z, info, err = openFile(filePath); err != nil {
+		return
+	}
+	defer f.Close()
+
+	snapshot = &Snapshot{Info: info, Data: make([][]byte, 0, len(info.Regions))}
+	for _, region := range info.Regions {
+		data := make([]byte, region.Size)
+		if _, err = io.ReadFull(z, data); err != nil {
+			err = fmt.Errorf("snapshots: %s: region $%06x: %w", filePath, region.Address, err)
+			snapshot = nil
+			return
+		}
+		snapshot.Data = append(snapshot.Data, data)
+	}
+
+	return
+}
@@ -0,0 +1,391 @@
+package snapshots
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/protos/sni"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Device is the subset of a device needed to capture and restore snapshots
+type Device interface {
+	devices.DeviceMemory
+	devices.DeviceInfo
+}
+
+// Region is a memory region in the FX Pak Pro address space
+type Region struct {
+	Address uint32 `json:"address"`
+	Size    uint32 `json:"size"`
+}
+
+// Info describes a stored snapshot
+type Info struct {
+	Name         string    `json:"name"`
+	CreatedAt    time.Time `json:"createdAt"`
+	RomFileName  string    `json:"romFileName"`
+	RomHashType  string    `json:"romHashType"`
+	RomHashValue string    `json:"romHashValue"`
+	Regions      []Region  `json:"regions"`
+}
+
+// Snapshot is a stored snapshot with the contents of each of its regions
+type Snapshot struct {
+	Info
+	Data [][]byte `json:"-"`
+}
+
+var nameRegexp = regexp.MustCompile(`^[A-Za-z0-9_\-][A-Za-z0-9_\-. ]*$`)
+
+// Dir returns the directory snapshots are stored in
+func Dir() string {
+	return filepath.Join(config.Dir, "snapshots")
+}
+
+func path(name string) (string, error) {
+	if len(name) > 128 || !nameRegexp.MatchString(name) {
+		return "", devices.WithCode(codes.InvalidArgument, fmt.Errorf("snapshots: invalid snapshot name %q", name))
+	}
+	return filepath.Join(Dir(), name+fileExt), nil
+}
+
+// ParseRegions parses a comma-delimited list of hexadecimal address:size pairs, e.g. "F50000:20000,E00000:8000"
+func ParseRegions(s string) (regions []Region, err error) {
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.Split(pair, ":")
+		if len(parts) != 2 {
+			err = fmt.Errorf("snapshots: region %q must be in address:size form", pair)
+			return
+		}
+
+		var address, size uint64
+		if address, err = strconv.ParseUint(strings.TrimPrefix(parts[0], "$"), 16, 24); err != nil {
+			err = fmt.Errorf("snapshots: region %q address: %w", pair, err)
+			return
+		}
+		if size, err = strconv.ParseUint(strings.TrimPrefix(parts[1], "$"), 16, 24); err != nil {
+			err = fmt.Errorf("snapshots: region %q size: %w", pair, err)
+			return
+		}
+
+		regions = append(regions, Region{Address: uint32(address), Size: uint32(size)})
+	}
+	return
+}
+
+// DefaultRegions returns the configured regions to capture when a request does not specify any
+func DefaultRegions() ([]Region, error) {
+	return ParseRegions(config.Config.GetString("snapshot_regions"))
+}
+
+// KeptRegions returns the configured regions which are not written by a restore while the game is running
+func KeptRegions() ([]Region, error) {
+	return ParseRegions(config.Config.GetString("snapshot_keep"))
+}
+
+// withoutRegions returns the writes with the bytes inside the excluded regions left out
+func withoutRegions(writes []devices.MemoryWriteRequest, exclude []Region) []devices.MemoryWriteRequest {
+	for _, x := range exclude {
+		kept := make([]devices.MemoryWriteRequest, 0, len(writes)+1)
+		for _, w := range writes {
+			start, end := w.RequestAddress.Address, w.RequestAddress.Address+uint32(len(w.Data))
+			if x.Address+x.Size <= start || x.Address >= end {
+				kept = append(kept, w)
+				continue
+			}
+
+			if x.Address > start {
+				before := w
+				before.Data = w.Data[:x.Address-start]
+				kept = append(kept, before)
+			}
+			if x.Address+x.Size < end {
+				after := w
+				after.RequestAddress.Address = x.Address + x.Size
+				after.Data = w.Data[x.Address+x.Size-start:]
+				kept = append(kept, after)
+			}
+		}
+		writes = kept
+	}
+	return writes
+}
+
+// pauseDevice pauses emulation if pause is true and the device can be controlled. It returns whether the device was
+// asked to pause and the function to resume it.
+func pauseDevice(ctx context.Context, device Device, pause bool) (paused bool, resume func() error) {
+	resume = func() error { return nil }
+	pauser, ok := device.(interface {
+		devices.DeviceControl
+		devices.DeviceInfo
+	})
+	if !pause || !ok {
+		return
+	}
+	return true, devices.PauseEmulation(ctx, pauser)
+}
+
+// romIdentity fetches the file name and hash of the ROM loaded on the device
+func romIdentity(ctx context.Context, device Device) (fileName, hashType, hashValue string, err error) {
+	var values []string
+	values, err = device.FetchFields(ctx, sni.Field_RomFileName, sni.Field_RomHashType, sni.Field_RomHashValue)
+	if err != nil {
+		return
+	}
+	if len(values) != 3 {
+		err = fmt.Errorf("snapshots: expected 3 field values but got %d", len(values))
+		return
+	}
+
+	fileName, hashType, hashValue = values[0], values[1], values[2]
+	return
+}
+
+// Capture reads the regions from the device and stores them as the named snapshot. Regions which the device cannot
+// read are left out of the snapshot. All regions are read with a single request, and if pause is true and the device
+// implements devices.DeviceControl, emulation is paused while they are read so that the regions are consistent with
+// each other.
+func Capture(ctx context.Context, device Device, name string, regions []Region, pause bool) (info Info, err error) {
+	var filePath string
+	if filePath, err = path(name); err != nil {
+		return
+	}
+	if len(regions) == 0 {
+		if regions, err = DefaultRegions(); err != nil {
+			return
+		}
+	}
+
+	snapshot := Snapshot{
+		Info: Info{
+			Name:      name,
+			CreatedAt: time.Now(),
+		},
+	}
+	snapshot.RomFileName, snapshot.RomHashType, snapshot.RomHashValue, err = romIdentity(ctx, device)
+	if err != nil {
+		return
+	}
+	if snapshot.RomHashValue == "" {
+		err = devices.WithCode(codes.FailedPrecondition, fmt.Errorf("snapshots: no ROM is loaded"))
+		return
+	}
+
+	reads := make([]devices.MemoryReadRequest, 0, len(regions))
+	for _, region := range regions {
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       region.Address,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: sni.MemoryMapping_Unknown,
+			},
+			Size: int(region.Size),
+		})
+	}
+
+	var rsps []devices.MemoryReadResponse
+	func() {
+		_, resume := pauseDevice(ctx, device, pause)
+		defer func() {
+			if rerr := resume(); rerr != nil && err == nil {
+				err = rerr
+			}
+		}()
+
+		rsps, err = device.MultiReadMemory(ctx, reads...)
+		if err != nil && !devices.IsFatal(err) {
+			// find out which of the regions cannot be read:
+			rsps, err = readEach(ctx, device, name, reads)
+		}
+	}()
+	if err != nil {
+		return
+	}
+
+	for i, region := range regions {
+		// store the size actually read so that Restore never writes past the data:
+		data := rsps[i].Data
+		if len(data) != int(region.Size) {
+			log.Printf("snapshots: capture %q: region $%06x:$%x read $%x bytes\n", name, region.Address, region.Size, len(data))
+			if len(data) == 0 {
+				continue
+			}
+			region.Size = uint32(len(data))
+		}
+
+		snapshot.Regions = append(snapshot.Regions, region)
+		snapshot.Data = append(snapshot.Data, data)
+	}
+
+	if len(snapshot.Regions) == 0 {
+		err = devices.WithCode(codes.FailedPrecondition, fmt.Errorf("snapshots: none of the regions could be read"))
+		return
+	}
+
+	if err = os.MkdirAll(Dir(), 0755|os.ModeDir); err != nil {
+		return
+	}
+	if err = writeFile(filePath, &snapshot); err != nil {
+		return
+	}
+
+	info = snapshot.Info
+	return
+}
+
+// readEach reads each region on its own and leaves the data of regions which cannot be read empty
+func readEach(ctx context.Context, device Device, name string, reads []devices.MemoryReadRequest) (rsps []devices.MemoryReadResponse, err error) {
+	rsps = make([]devices.MemoryReadResponse, len(reads))
+	for i, read := range reads {
+		var rsp []devices.MemoryReadResponse
+		rsp, err = device.MultiReadMemory(ctx, read)
+		if err != nil {
+			if devices.IsFatal(err) {
+				return
+			}
+			log.Printf(
+				"snapshots: capture %q: skipping unreadable region $%06x:$%x: %v\n",
+				name,
+				read.RequestAddress.Address,
+				read.Size,
+				err,
+			)
+			err = nil
+			continue
+		}
+		rsps[i] = rsp[0]
+	}
+	return
+}
+
+// Restore writes the named snapshot's regions to the device. It refuses to restore a snapshot which was captured
+// from a different ROM than the one loaded on the device. If pause is true and the device implements
+// devices.DeviceControl, emulation is paused while the regions are written. Otherwise the game keeps running and
+// may observe a partially restored snapshot, e.g. the FX Pak Pro writes WRAM in many separate NMI copies, so the
+// KeptRegions, such as the direct page and stack the running game depends on, are left as they are.
+func Restore(ctx context.Context, device Device, name string, pause bool) (info Info, err error) {
+	var filePath string
+	if filePath, err = path(name); err != nil {
+		return
+	}
+
+	var snapshot *Snapshot
+	if snapshot, err = readFile(filePath); err != nil {
+		return
+	}
+
+	var fileName, hashType, hashValue string
+	fileName, hashType, hashValue, err = romIdentity(ctx, device)
+	if err != nil {
+		return
+	}
+	if hashType != snapshot.RomHashType || hashValue != snapshot.RomHashValue {
+		err = devices.WithCode(
+			codes.FailedPrecondition,
+			fmt.Errorf(
+				"snapshots: snapshot %q was captured from ROM %q (%s %s) but the device is running ROM %q (%s %s)",
+				name,
+				snapshot.RomFileName,
+				snapshot.RomHashType,
+				snapshot.RomHashValue,
+				fileName,
+				hashType,
+				hashValue,
+			),
+		)
+		return
+	}
+
+	writes := make([]devices.MemoryWriteRequest, 0, len(snapshot.Regions))
+	for i, region := range snapshot.Regions {
+		writes = append(writes, devices.MemoryWriteRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       region.Address,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: sni.MemoryMapping_Unknown,
+			},
+			Data: snapshot.Data[i],
+		})
+	}
+
+	var keep []Region
+	if keep, err = KeptRegions(); err != nil {
+		return
+	}
+
+	paused, resume := pauseDevice(ctx, device, pause)
+	defer func() {
+		if rerr := resume(); rerr != nil && err == nil {
+			err = rerr
+		}
+	}()
+	if !paused {
+		writes = withoutRegions(writes, keep)
+	}
+
+	if _, err = device.MultiWriteMemory(ctx, writes...); err != nil {
+		return
+	}
+
+	info = snapshot.Info
+	return
+}
+
+// List returns the stored snapshots ordered by name
+func List() (infos []Info, err error) {
+	var entries []os.DirEntry
+	entries, err = os.ReadDir(Dir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+
+	infos = make([]Info, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), fileExt) {
+			continue
+		}
+
+		var info Info
+		if info, err = readInfo(filepath.Join(Dir(), entry.Name())); err != nil {
+			log.Printf("snapshots: list: skipping %s: %v\n", entry.Name(), err)
+			err = nil
+			continue
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return
+}
+
+// Delete removes the named snapshot
+func Delete(name string) (err error) {
+	var filePath string
+	if filePath, err = path(name); err != nil {
+		return
+	}
+
+	err = os.Remove(filePath)
+	if os.IsNotExist(err) {
+		err = devices.WithCode(codes.NotFound, fmt.Errorf("snapshots: snapshot %q not found", name))
+	}
+	return
+}
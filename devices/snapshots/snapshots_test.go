@@ -0,0 +1,205 @@
+package snapshots
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/drivers/mock"
+	"sni/protos/sni"
+	"testing"
+)
+
+func newTestDevice(t *testing.T, title string) *mock.Device {
+	t.Helper()
+
+	d := &mock.Device{}
+	d.Init()
+	t.Cleanup(func() { _ = d.Close() })
+
+	if err := d.LoadROM(context.Background(), title+".sfc", mock.LoROMImage(title, 0x10000)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.PauseUnpause(context.Background(), true); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestCaptureRestore(t *testing.T) {
+	config.Dir = t.TempDir()
+	ctx := context.Background()
+
+	d := newTestDevice(t, "SNAPSHOT TEST")
+	regions := []Region{{Address: 0xF50100, Size: 0x100}, {Address: 0xE00000, Size: 0x20}}
+	for i := 0; i < 0x100; i++ {
+		d.Memory[0xF50100+i] = byte(i)
+	}
+	d.Memory[0xE00000] = 0x42
+
+	info, err := Capture(ctx, d, "practice 1", regions, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.RomFileName != "SNAPSHOT TEST.sfc" || info.RomHashValue == "" || len(info.Regions) != 2 {
+		t.Fatalf("Capture = %+v", info)
+	}
+
+	// clobber the captured memory and restore it:
+	for i := 0; i < 0x100; i++ {
+		d.Memory[0xF50100+i] = 0xFF
+	}
+	d.Memory[0xE00000] = 0
+
+	if _, err = Restore(ctx, d, "practice 1", true); err != nil {
+		t.Fatal(err)
+	}
+	// the device was paused before restoring so it must stay paused:
+	if values, _ := d.FetchFields(ctx, sni.Field_DeviceStatus); values[0] != "PAUSED" {
+		t.Fatalf("DeviceStatus after Restore = %q", values[0])
+	}
+	for i := 0; i < 0x100; i++ {
+		if d.Memory[0xF50100+i] != byte(i) {
+			t.Fatalf("WRAM $%06x = $%02x after Restore", 0xF50100+i, d.Memory[0xF50100+i])
+		}
+	}
+	if d.Memory[0xE00000] != 0x42 {
+		t.Fatalf("SRAM $E00000 = $%02x after Restore", d.Memory[0xE00000])
+	}
+
+	infos, err := List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 1 || infos[0].Name != "practice 1" || len(infos[0].Regions) != 2 {
+		t.Fatalf("List = %+v", infos)
+	}
+
+	if err = Delete("practice 1"); err != nil {
+		t.Fatal(err)
+	}
+	if infos, err = List(); err != nil || len(infos) != 0 {
+		t.Fatalf("List after Delete = %+v, %v", infos, err)
+	}
+}
+
+func TestCapture_unreadableRegion(t *testing.T) {
+	config.Dir = t.TempDir()
+	ctx := context.Background()
+
+	d := newTestDevice(t, "SNAPSHOT TEST")
+	d.Memory[0xF50000] = 0x42
+
+	info, err := Capture(ctx, d, "partial", []Region{{Address: 0xFFFFF0, Size: 0x20}, {Address: 0xF50000, Size: 0x10}}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Regions) != 1 || info.Regions[0] != (Region{Address: 0xF50000, Size: 0x10}) {
+		t.Fatalf("Capture regions = %+v", info.Regions)
+	}
+}
+
+func TestRestore_unpaused(t *testing.T) {
+	config.Dir = t.TempDir()
+	config.Config.Set("snapshot_keep", "F50000:200")
+	ctx := context.Background()
+
+	d := newTestDevice(t, "SNAPSHOT TEST")
+	for i := 0; i < 0x400; i++ {
+		d.Memory[0xF50000+i] = byte(i)
+	}
+	if _, err := Capture(ctx, d, "wram", []Region{{Address: 0xF50000, Size: 0x400}}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 0x400; i++ {
+		d.Memory[0xF50000+i] = 0xFF
+	}
+	if _, err := Restore(ctx, d, "wram", false); err != nil {
+		t.Fatal(err)
+	}
+
+	// the direct page and stack of the running game must be left alone:
+	for i := 0; i < 0x400; i++ {
+		want := byte(i)
+		if i < 0x200 {
+			want = 0xFF
+		}
+		if d.Memory[0xF50000+i] != want {
+			t.Fatalf("WRAM $%06x = $%02x after Restore, want $%02x", 0xF50000+i, d.Memory[0xF50000+i], want)
+		}
+	}
+}
+
+func TestWithoutRegions(t *testing.T) {
+	write := func(address uint32, size int) devices.MemoryWriteRequest {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(address) + byte(i)
+		}
+		return devices.MemoryWriteRequest{
+			RequestAddress: devices.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_FxPakPro},
+			Data:           data,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		writes  []devices.MemoryWriteRequest
+		exclude []Region
+		want    []devices.MemoryWriteRequest
+	}{
+		{"none", []devices.MemoryWriteRequest{write(0x00, 0x10)}, nil, []devices.MemoryWriteRequest{write(0x00, 0x10)}},
+		{"disjoint", []devices.MemoryWriteRequest{write(0x00, 0x10)}, []Region{{0x10, 0x10}}, []devices.MemoryWriteRequest{write(0x00, 0x10)}},
+		{"all", []devices.MemoryWriteRequest{write(0x04, 0x08)}, []Region{{0x00, 0x10}}, []devices.MemoryWriteRequest{}},
+		{"head", []devices.MemoryWriteRequest{write(0x00, 0x10)}, []Region{{0x00, 0x08}}, []devices.MemoryWriteRequest{write(0x08, 0x08)}},
+		{"tail", []devices.MemoryWriteRequest{write(0x00, 0x10)}, []Region{{0x08, 0x10}}, []devices.MemoryWriteRequest{write(0x00, 0x08)}},
+		{"middle", []devices.MemoryWriteRequest{write(0x00, 0x10)}, []Region{{0x04, 0x04}}, []devices.MemoryWriteRequest{write(0x00, 0x04), write(0x08, 0x08)}},
+		{
+			"several",
+			[]devices.MemoryWriteRequest{write(0x00, 0x10), write(0x20, 0x10)},
+			[]Region{{0x08, 0x20}, {0x2C, 0x02}},
+			[]devices.MemoryWriteRequest{write(0x00, 0x08), write(0x28, 0x04), write(0x2E, 0x02)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withoutRegions(tt.writes, tt.exclude)
+			if len(got) != len(tt.want) {
+				t.Fatalf("withoutRegions() = %d writes, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i].RequestAddress != tt.want[i].RequestAddress || !bytes.Equal(got[i].Data, tt.want[i].Data) {
+					t.Errorf("write %d = $%06x % x, want $%06x % x", i, got[i].RequestAddress.Address, got[i].Data, tt.want[i].RequestAddress.Address, tt.want[i].Data)
+				}
+			}
+		})
+	}
+}
+
+func TestRestore_differentROM(t *testing.T) {
+	config.Dir = t.TempDir()
+	ctx := context.Background()
+
+	if _, err := Capture(ctx, newTestDevice(t, "FIRST GAME"), "save", []Region{{Address: 0xF50000, Size: 0x10}}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Restore(ctx, newTestDevice(t, "SECOND GAME"), "save", true)
+	var coded *devices.CodedError
+	if !errors.As(err, &coded) || coded.Code != codes.FailedPrecondition {
+		t.Fatalf("Restore to a different ROM = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestCapture_invalidName(t *testing.T) {
+	config.Dir = t.TempDir()
+
+	for _, name := range []string{"", "../escape", "a/b", ".hidden"} {
+		if _, err := Capture(context.Background(), nil, name, nil, true); err == nil {
+			t.Fatalf("Capture(%q) succeeded", name)
+		}
+	}
+}
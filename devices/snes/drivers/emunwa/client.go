@@ -13,6 +13,7 @@ import (
 	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/devices/snes/mapping"
+	"sni/devices/snes/romhash"
 	"sni/protos/sni"
 	"strings"
 	"sync"
@@ -34,16 +35,28 @@ type Client struct {
 
 	readWriteTimeout time.Duration
 	dialer           *net.Dialer
+
+	romHash romhash.Cache
 }
 
 func (c *Client) FatalError(cause error) devices.DeviceError {
-	return devices.DeviceFatal(fmt.Sprintf("emunwa: %v", cause), cause)
+	kind := devices.KindOf(cause)
+	if kind == devices.ErrorKindUnknown {
+		// fatal errors come from the socket so treat them as a lost connection:
+		kind = devices.ErrorKindDisconnected
+	}
+	return devices.DeviceFatalKind(kind, fmt.Sprintf("emunwa: %v", cause), cause)
 }
 
 func (c *Client) NonFatalError(cause error) devices.DeviceError {
 	return devices.DeviceNonFatal(fmt.Sprintf("emunwa: %v", cause), cause)
 }
 
+// ProtocolError is fatal since the reply stream can no longer be trusted
+func (c *Client) ProtocolError(cause error) devices.DeviceError {
+	return devices.DeviceFatalKind(devices.ErrorKindProtocolViolation, fmt.Sprintf("emunwa: %v", cause), cause)
+}
+
 func NewClient(addr *net.TCPAddr, name string, timeout time.Duration) (c *Client) {
 	c = &Client{
 		addr:             addr,
@@ -262,7 +275,7 @@ func (c *Client) parseResponse(r *bufio.Reader) (bin []byte, ascii []map[string]
 
 	// expect ascii reply otherwise:
 	if d != '\n' {
-		err = fmt.Errorf("emunwa: command reply expected starting with '\\0' or '\\n' but got '%c'", d)
+		err = c.ProtocolError(fmt.Errorf("command reply expected starting with '\\0' or '\\n' but got '%c'", d))
 		return
 	}
 
@@ -357,6 +370,14 @@ func (c *Client) MultiReadMemory(ctx context.Context, reads ...devices.MemoryRea
 	// divide up the reads into memory type groups:
 	for j, read := range reads {
 		memType, pakAddress, offset := mapping.MemoryTypeFor(read.RequestAddress)
+		if !memType.HasEmulatorDomain() {
+			err = devices.DeviceNonFatalKind(
+				devices.ErrorKindUnsupportedDomain,
+				fmt.Sprintf("emunwa: memory type %s is not accessible on emulators", memType),
+				nil,
+			)
+			return
+		}
 
 		mrsp[j].RequestAddress = read.RequestAddress
 		mrsp[j].DeviceAddress = devices.AddressTuple{
@@ -408,7 +429,7 @@ func (c *Client) MultiReadMemory(ctx context.Context, reads ...devices.MemoryRea
 			return
 		}
 		if ascii != nil {
-			err = fmt.Errorf("emunwa: expecting binary reply but got ascii:\n%+v", ascii)
+			err = c.ProtocolError(fmt.Errorf("expecting binary reply but got ascii:\n%+v", ascii))
 			return
 		}
 
@@ -448,6 +469,14 @@ func (c *Client) MultiWriteMemory(ctx context.Context, writes ...devices.MemoryW
 	// divide up the writes into memory type groups:
 	for j, write := range writes {
 		memType, pakAddress, offset := mapping.MemoryTypeFor(write.RequestAddress)
+		if !memType.HasEmulatorDomain() {
+			err = devices.DeviceNonFatalKind(
+				devices.ErrorKindUnsupportedDomain,
+				fmt.Sprintf("emunwa: memory type %s is not accessible on emulators", memType),
+				nil,
+			)
+			return
+		}
 
 		mrsp[j].RequestAddress = write.RequestAddress
 		mrsp[j].DeviceAddress = devices.AddressTuple{
@@ -607,21 +636,22 @@ func (c *Client) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 
 	for _, field := range fields {
 		switch field {
-		case sni.Field_DeviceName:
-		case sni.Field_DeviceVersion:
+		case sni.Field_DeviceName, sni.Field_DeviceVersion:
 			wantEmulatorInfo = true
 			break
 		case sni.Field_DeviceStatus:
 			wantEmulationStatus = true
 			break
-		case sni.Field_CoreName:
-		case sni.Field_CoreVersion:
-		case sni.Field_CorePlatform:
+		case sni.Field_CoreName, sni.Field_CoreVersion, sni.Field_CorePlatform:
 			wantCoreInfo = true
 			break
 		case sni.Field_RomFileName:
 			wantGameInfo = true
 			break
+		case sni.Field_RomHashValue:
+			// GAME_INFO may report the hash and otherwise the file name identifies the cached hash:
+			wantGameInfo = true
+			break
 		}
 	}
 
@@ -684,6 +714,24 @@ func (c *Client) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 		case sni.Field_RomFileName:
 			values = append(values, getFirstValue(gameInfo, "file"))
 			break
+		case sni.Field_RomHashType:
+			values = append(values, romhash.Type())
+			break
+		case sni.Field_RomHashValue:
+			// use the hash if GAME_INFO reports one of the configured type and otherwise read the ROM:
+			hashValue := strings.ToLower(getFirstValue(gameInfo, romhash.Type()))
+			if hashValue != "" {
+				values = append(values, hashValue)
+				break
+			}
+			_, hashValue, err = c.romHash.Get(ctx, getFirstValue(gameInfo, "file"), func(ctx context.Context) ([]byte, error) {
+				return romhash.ReadROM(ctx, c)
+			})
+			if err != nil {
+				return
+			}
+			values = append(values, hashValue)
+			break
 		default:
 			values = append(values, "")
 			break
@@ -692,3 +740,8 @@ func (c *Client) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 
 	return
 }
+
+func (c *Client) CompareAndWriteMemory(ctx context.Context, request devices.MemoryCompareAndWriteRequest) (devices.MemoryCompareAndWriteResponse, error) {
+	// emulator does not support atomic compare-and-write so fall back to read-compare-write while paused:
+	return devices.CompareAndWriteWhilePaused(ctx, c, request)
+}
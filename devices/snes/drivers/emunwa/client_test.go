@@ -3,8 +3,12 @@ package emunwa
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"net"
 	"reflect"
+	"sni/protos/sni"
 	"testing"
+	"time"
 )
 
 func Test_parseResponse(t *testing.T) {
@@ -89,3 +93,76 @@ func Test_parseResponse(t *testing.T) {
 		})
 	}
 }
+
+// fakeEmulator accepts one connection and answers each command line with the reply returned by reply
+func fakeEmulator(t *testing.T, reply func(cmd string) string) *Client {
+	t.Helper()
+
+	l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if _, err = conn.Write([]byte(reply(line[:len(line)-1]))); err != nil {
+				return
+			}
+		}
+	}()
+
+	c := NewClient(l.Addr().(*net.TCPAddr), "emunwa", time.Second)
+	if err = c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestClient_FetchFields_gameInfo(t *testing.T) {
+	c := fakeEmulator(t, func(cmd string) string {
+		if cmd != "GAME_INFO" {
+			t.Errorf("unexpected command %q", cmd)
+		}
+		return "\nname:test\nfile:/roms/test.sfc\ncrc32:3322EFFC\n\n"
+	})
+
+	values, err := c.FetchFields(context.Background(), sni.Field_RomFileName, sni.Field_RomHashType, sni.Field_RomHashValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"/roms/test.sfc", "crc32", "3322effc"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("FetchFields() = %v, want %v", values, want)
+	}
+}
+
+func TestClient_BootFile(t *testing.T) {
+	commands := make(chan string, 1)
+	c := fakeEmulator(t, func(cmd string) string {
+		commands <- cmd
+		return "\n\n"
+	})
+
+	if err := c.BootFile(context.Background(), "/roms/seed 1.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if cmd := <-commands; cmd != "LOAD_GAME /roms/seed 1.sfc" {
+		t.Errorf("sent %q, want LOAD_GAME", cmd)
+	}
+
+	if err := c.BootFile(context.Background(), "a\nb"); err == nil {
+		t.Errorf("BootFile() with a line break succeeded")
+	}
+}
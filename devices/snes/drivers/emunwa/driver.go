@@ -71,6 +71,11 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_PauseUnpauseEmulation,
 	sni.DeviceCapability_FetchFields,
 	sni.DeviceCapability_NWACommand,
+	sni.DeviceCapability_BootFile,
+	sni.DeviceCapability_SaveState,
+	sni.DeviceCapability_LoadState,
+	sni.DeviceCapability_SaveStateSlot,
+	sni.DeviceCapability_LoadStateSlot,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
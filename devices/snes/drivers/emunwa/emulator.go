@@ -0,0 +1,79 @@
+package emunwa
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"strings"
+	"time"
+)
+
+// NWA's SAVE_STATE and LOAD_STATE commands take a file path and have no notion of slots so slots are mapped to
+// files in the states directory of the SNI configuration, named after the loaded game.
+
+// statePath returns the path of the state file for the given slot of the loaded game
+func (c *Client) statePath(slot int, deadline time.Time) (path string, err error) {
+	if slot < 0 {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("emunwa: a state slot is required"))
+		return
+	}
+
+	var gameInfo []map[string]string
+	_, gameInfo, err = c.SendCommandWaitReply("GAME_INFO", deadline)
+	if err != nil {
+		return
+	}
+	file := getFirstValue(gameInfo, "file")
+	if file == "" {
+		err = devices.DeviceNonFatal("emunwa: no game is loaded", nil)
+		return
+	}
+
+	base := filepath.Base(strings.ReplaceAll(file, "\\", "/"))
+	base = strings.TrimSuffix(base, filepath.Ext(base))
+
+	dir := filepath.Join(config.Dir, "states")
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		err = devices.DeviceNonFatal(fmt.Sprintf("emunwa: %v", err), err)
+		return
+	}
+
+	path = filepath.Join(dir, fmt.Sprintf("%s.%d.state", base, slot))
+	return
+}
+
+func (c *Client) SaveState(ctx context.Context, slot int) (err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	var path string
+	if path, err = c.statePath(slot, deadline); err != nil {
+		return
+	}
+	_, _, err = c.SendCommandWaitReply("SAVE_STATE "+path, deadline)
+	return
+}
+
+func (c *Client) LoadState(ctx context.Context, slot int) (err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	var path string
+	if path, err = c.statePath(slot, deadline); err != nil {
+		return
+	}
+	_, _, err = c.SendCommandWaitReply("LOAD_STATE "+path, deadline)
+	return
+}
+
+func (c *Client) Screenshot(ctx context.Context) error {
+	return devices.WithCode(codes.Unimplemented, fmt.Errorf("emunwa: screenshots are not supported by the protocol"))
+}
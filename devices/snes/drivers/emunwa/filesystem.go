@@ -0,0 +1,59 @@
+package emunwa
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"io"
+	"sni/devices"
+	"strings"
+	"time"
+)
+
+// The NWA protocol has no file transfer or game listing commands; only BootFile is supported, which loads a game
+// from the filesystem of the machine running the emulator.
+
+func errFilesystemUnavailable(op string) error {
+	return devices.WithCode(codes.Unimplemented, fmt.Errorf("emunwa: %s is not supported by the protocol", op))
+}
+
+func (c *Client) ReadDirectory(ctx context.Context, path string) ([]devices.DirEntry, error) {
+	return nil, errFilesystemUnavailable("ReadDirectory")
+}
+
+func (c *Client) MakeDirectory(ctx context.Context, path string) error {
+	return errFilesystemUnavailable("MakeDirectory")
+}
+
+func (c *Client) RemoveFile(ctx context.Context, path string) error {
+	return errFilesystemUnavailable("RemoveFile")
+}
+
+func (c *Client) RenameFile(ctx context.Context, path, newFilename string) error {
+	return errFilesystemUnavailable("RenameFile")
+}
+
+func (c *Client) PutFile(ctx context.Context, path string, size uint32, r io.Reader, progress devices.ProgressReportFunc) (uint32, error) {
+	return 0, errFilesystemUnavailable("PutFile")
+}
+
+func (c *Client) GetFile(ctx context.Context, path string, w io.Writer, sizeReceived devices.SizeReceivedFunc, progress devices.ProgressReportFunc) (uint32, error) {
+	return 0, errFilesystemUnavailable("GetFile")
+}
+
+// BootFile loads the game at path with LOAD_GAME using the emulator's current core
+func (c *Client) BootFile(ctx context.Context, path string) (err error) {
+	if strings.ContainsAny(path, "\r\n") {
+		return devices.WithCode(codes.InvalidArgument, fmt.Errorf("emunwa: path must not contain line breaks"))
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	// a game regenerated under the same file name has a different hash:
+	c.romHash.Reset()
+	_, _, err = c.SendCommandWaitReply("LOAD_GAME "+path, deadline)
+	return
+}
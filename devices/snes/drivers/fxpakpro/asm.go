@@ -0,0 +1,102 @@
+package fxpakpro
+
+import (
+	"context"
+	"fmt"
+	"github.com/alttpo/snes/asm"
+	"sni/devices"
+)
+
+const (
+	// usbexeCodeAddress is where user code is loaded following the NMI wrapper generated by GenerateExecuteAsm:
+	usbexeCodeAddress = 0x2C20
+	// usbexeMaxCodeSize is the most user code that fits in the USB EXE buffer after the NMI wrapper:
+	usbexeMaxCodeSize = 512 - (usbexeCodeAddress - 0x2C00)
+)
+
+func (d *Device) ExecuteASM(
+	ctx context.Context,
+	code []byte,
+	waitForCompletion bool,
+	results ...devices.MemoryReadRequest,
+) (completed bool, rsp []devices.MemoryReadResponse, err error) {
+	if actual, expected := len(code), usbexeMaxCodeSize; actual > expected {
+		err = d.NonFatalError(fmt.Errorf("fxpakpro: code too large to execute; %d > %d", actual, expected))
+		return
+	}
+
+	buf := [512]byte{}
+	a := asm.NewEmitter(buf[:], true)
+	GenerateExecuteAsm(a, code)
+
+	if debugLog != nil {
+		a.WriteTextTo(debugLog.Writer())
+	}
+
+	subctx := ctx
+	if shouldLock(ctx) {
+		// lock the device for this entire sequence to avoid interruptions:
+		d.lock.Lock()
+		defer d.lock.Unlock()
+		subctx = context.WithValue(ctx, lockedKey, &struct{}{})
+	}
+
+	completed, err = d.executeUSBEXE(subctx, buf[:a.Len()], waitForCompletion || len(results) > 0)
+	if err != nil {
+		return
+	}
+
+	if len(results) == 0 {
+		return
+	}
+
+	rsp, err = d.MultiReadMemory(subctx, results...)
+	return
+}
+
+// GenerateExecuteAsm emits an NMI wrapper routine at $2C00 that preserves registers, calls the given code via JSL
+// at usbexeCodeAddress, then disables the NMI vector override and jumps to the original NMI routine.
+// The given code must return via RTL.
+func GenerateExecuteAsm(a *asm.Emitter, code []byte) {
+	a.SetBase(0x002C00)
+
+	a.Comment("preserve registers:")
+	a.PHP()
+	a.REP(0x30)
+	a.PHA()
+	a.PHX()
+	a.PHY()
+	a.PHB()
+	a.PHD()
+
+	a.Comment("set DBR to $00:")
+	a.PHK()
+	a.PLB()
+
+	a.Comment("call user code:")
+	a.JSL(usbexeCodeAddress)
+
+	a.Comment("disable NMI vector override:")
+	a.REP(0x30)
+	a.LDA_imm16_w(0)
+	a.STA_long(0x002C00)
+
+	a.Comment("restore registers:")
+	a.PLD()
+	a.PLB()
+	a.PLY()
+	a.PLX()
+	a.PLA()
+	a.PLP()
+
+	a.Comment("jump to original NMI:")
+	a.JMP_indirect(0xFFEA)
+
+	// bug check: make sure emitted code is the expected size
+	if actual, expected := a.Len(), usbexeCodeAddress-0x2C00; actual != expected {
+		panic(fmt.Errorf("bug check: emitted code size %d != %d", actual, expected))
+	}
+
+	a.Comment("user code:")
+	a.EmitBytes(code)
+}
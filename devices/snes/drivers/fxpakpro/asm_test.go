@@ -0,0 +1,76 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"github.com/alttpo/snes/asm"
+	"log"
+	"sni/devices"
+	"sni/protos/sni"
+	"testing"
+)
+
+func TestGenerateExecuteAsm(t *testing.T) {
+	// LDA #$55; STA $2C10; RTL
+	userCode := []byte{0xA9, 0x55, 0x8D, 0x10, 0x2C, 0x6B}
+
+	code := [512]byte{}
+	a := asm.NewEmitter(code[:], true)
+	GenerateExecuteAsm(a, userCode)
+	a.WriteTextTo(log.Writer())
+
+	if actual, expected := a.Len(), usbexeCodeAddress-0x2C00+len(userCode); actual != expected {
+		t.Fatalf("GenerateExecuteAsm length %d want %d", actual, expected)
+	}
+	if code[0] == 0 {
+		t.Fatal("GenerateExecuteAsm first byte must be non-zero to enable NMI override")
+	}
+	if actual := code[usbexeCodeAddress-0x2C00 : a.Len()]; !bytes.Equal(actual, userCode) {
+		t.Fatalf("GenerateExecuteAsm user code %#v want %#v", actual, userCode)
+	}
+}
+
+func TestDevice_ExecuteASM(t *testing.T) {
+	d := openAutoCloseableDevice(t)
+	defer d.Close()
+
+	ctx := context.Background()
+
+	// SEP #$20; LDA #$55; STA $7E:FFFE; RTL
+	code := []byte{0xE2, 0x20, 0xA9, 0x55, 0x8F, 0xFE, 0xFF, 0x7E, 0x6B}
+
+	completed, rsp, err := d.ExecuteASM(ctx, code, true, devices.MemoryReadRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:       0xF5FFFE,
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: sni.MemoryMapping_LoROM,
+		},
+		Size: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !completed {
+		t.Fatal("expected completed")
+	}
+	if rsp[0].Data[0] != 0x55 {
+		t.Fatalf("expected $55 got $%02x", rsp[0].Data[0])
+	}
+}
+
+func TestGenerateCompareAndWriteAsm(t *testing.T) {
+	compare := make([]byte, compareAndWriteMaxSize)
+	data := make([]byte, compareAndWriteMaxSize)
+	for i := range data {
+		compare[i] = byte(i)
+		data[i] = byte(0x80 + i)
+	}
+
+	code := [usbexeMaxCodeSize]byte{}
+	a := asm.NewEmitter(code[:], true)
+	GenerateCompareAndWriteAsm(a, 0x7EF340, compare, data)
+	if err := a.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	a.WriteTextTo(log.Writer())
+}
@@ -36,12 +36,12 @@ func (d *Device) boot(ctx context.Context, path string) (err error) {
 	}
 	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
 		err = fmt.Errorf("boot: fxpakpro response packet does not contain USBA header")
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if sb[4] != byte(OpRESPONSE) {
 		err = fmt.Errorf("boot: wrong opcode in response packet; got $%02x", sb[4])
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if ec := sb[5]; ec != 0 {
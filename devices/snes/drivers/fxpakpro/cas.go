@@ -0,0 +1,143 @@
+package fxpakpro
+
+import (
+	"context"
+	"fmt"
+	"github.com/alttpo/snes/asm"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+)
+
+const (
+	// compareAndWriteMaxSize keeps all BNE branches in the generated routine within signed 8-bit range:
+	compareAndWriteMaxSize = 16
+	// usbexeResultAddress is the last byte of the USB EXE buffer which the generated routine writes its result to:
+	usbexeResultAddress = 0x2DFF
+
+	compareAndWriteMatched    = 0x01
+	compareAndWriteMismatched = 0x02
+)
+
+func (d *Device) CompareAndWriteMemory(
+	ctx context.Context,
+	request devices.MemoryCompareAndWriteRequest,
+) (rsp devices.MemoryCompareAndWriteResponse, err error) {
+	if len(request.Compare) != len(request.Data) {
+		err = d.NonFatalError(fmt.Errorf("compare length %d must equal data length %d", len(request.Compare), len(request.Data)))
+		return
+	}
+	if actual, expected := len(request.Data), compareAndWriteMaxSize; actual > expected {
+		err = d.NonFatalError(fmt.Errorf("compare and write size too large; %d > %d", actual, expected))
+		return
+	}
+
+	rsp = devices.MemoryCompareAndWriteResponse{
+		RequestAddress: request.RequestAddress,
+		DeviceAddress: devices.AddressTuple{
+			Address:       0,
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: request.RequestAddress.MemoryMapping,
+		},
+	}
+	rsp.DeviceAddress.Address, err = mapping.TranslateAddress(
+		request.RequestAddress,
+		sni.AddressSpace_FxPakPro,
+	)
+	if err != nil {
+		return
+	}
+
+	// the SNES CPU performs the compare and write so we need the A-bus address:
+	var busAddress uint32
+	if pakAddress := rsp.DeviceAddress.Address; pakAddress >= 0xF50000 && pakAddress < 0xF70000 {
+		// WRAM does not depend on memory mapping:
+		busAddress = pakAddress - 0xF50000 + 0x7E0000
+	} else {
+		busAddress, err = mapping.TranslateAddress(rsp.DeviceAddress, sni.AddressSpace_SnesABus)
+		if err != nil {
+			err = d.NonFatalError(fmt.Errorf("compare and write: %w", err))
+			return
+		}
+	}
+
+	code := [usbexeMaxCodeSize]byte{}
+	a := asm.NewEmitter(code[:], true)
+	GenerateCompareAndWriteAsm(a, busAddress, request.Compare, request.Data)
+	if err = a.Finalize(); err != nil {
+		return
+	}
+
+	if debugLog != nil {
+		a.WriteTextTo(debugLog.Writer())
+	}
+
+	subctx := ctx
+	if shouldLock(ctx) {
+		// lock the device for this entire sequence to avoid interruptions:
+		d.lock.Lock()
+		defer d.lock.Unlock()
+		subctx = context.WithValue(ctx, lockedKey, &struct{}{})
+	}
+
+	buf := [512]byte{}
+	w := asm.NewEmitter(buf[:], true)
+	GenerateExecuteAsm(w, a.Bytes())
+
+	_, err = d.executeUSBEXE(subctx, w.Bytes(), true)
+	if err != nil {
+		return
+	}
+
+	result := make([]byte, 1)
+	err = d.vget(subctx, SpaceCMD, vgetChunk{addr: usbexeResultAddress, size: 1, target: result})
+	if err != nil {
+		return
+	}
+
+	switch result[0] {
+	case compareAndWriteMatched:
+		rsp.Written = true
+	case compareAndWriteMismatched:
+		rsp.Written = false
+	default:
+		err = devices.DeviceNonFatalKind(
+			devices.ErrorKindProtocolViolation,
+			fmt.Sprintf("fxpakpro: compare and write: unexpected result $%02x", result[0]),
+			nil,
+		)
+	}
+	return
+}
+
+// GenerateCompareAndWriteAsm emits a routine to be called by GenerateExecuteAsm that compares the bytes at busAddress
+// against compare and only if all match writes data to busAddress. The result is stored at usbexeResultAddress.
+func GenerateCompareAndWriteAsm(a *asm.Emitter, busAddress uint32, compare []byte, data []byte) {
+	a.SetBase(usbexeCodeAddress)
+	// GenerateExecuteAsm calls us in 16-bit mode:
+	a.AssumeREP(0x30)
+
+	a.SEP(0x20)
+	a.Comment(fmt.Sprintf("compare $%x bytes at $%06x:", len(compare), busAddress))
+	for i, b := range compare {
+		a.LDA_long(busAddress + uint32(i))
+		a.CMP_imm8_b(b)
+		a.BNE("mismatch")
+	}
+	a.BRA("write")
+
+	a.Label("mismatch")
+	a.LDA_imm8_b(compareAndWriteMismatched)
+	a.STA_long(usbexeResultAddress)
+	a.RTL()
+
+	a.Label("write")
+	a.Comment(fmt.Sprintf("write $%x bytes to $%06x:", len(data), busAddress))
+	for i, b := range data {
+		a.LDA_imm8_b(b)
+		a.STA_long(busAddress + uint32(i))
+	}
+	a.LDA_imm8_b(compareAndWriteMatched)
+	a.STA_long(usbexeResultAddress)
+	a.RTL()
+}
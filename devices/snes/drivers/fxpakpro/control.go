@@ -2,7 +2,9 @@ package fxpakpro
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
+	"time"
 )
 
 func (d *Device) ResetSystem(ctx context.Context) (err error) {
@@ -31,7 +33,7 @@ func (d *Device) ResetSystem(ctx context.Context) (err error) {
 
 	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
 		err = fmt.Errorf("reset: fxpakpro response packet does not contain USBA header")
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if ec := sb[5]; ec != 0 {
@@ -69,7 +71,7 @@ func (d *Device) ResetToMenu(ctx context.Context) (err error) {
 
 	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
 		err = fmt.Errorf("menu_reset: fxpakpro response packet does not contain USBA header")
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if ec := sb[5]; ec != 0 {
@@ -81,6 +83,93 @@ func (d *Device) ResetToMenu(ctx context.Context) (err error) {
 	return
 }
 
+func (d *Device) PowerCycle(ctx context.Context) (err error) {
+	sb := make([]byte, 512)
+	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
+	sb[4] = byte(OpPOWER_CYCLE)
+	sb[5] = byte(SpaceSNES)
+	sb[6] = byte(FlagNONE)
+
+	if shouldLock(ctx) {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+	}
+
+	err = sendSerialChunked(d.f, 512, sb)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	err = recvSerial(ctx, d.f, sb, 512)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
+		err = fmt.Errorf("power_cycle: fxpakpro response packet does not contain USBA header")
+		err = d.ProtocolError(err)
+		return
+	}
+	if ec := sb[5]; ec != 0 {
+		err = fmt.Errorf("power_cycle: %w", fxpakproError(ec))
+		err = d.NonFatalError(err)
+		return
+	}
+
+	return
+}
+
+// SetRealTimeClock sends the TIME command which carries the local wall-clock time in bytes [256:264]: seconds,
+// minutes, hours, day of month (1-31), month (1-12), year (16-bit big-endian), and day of week (0 = Sunday).
+func (d *Device) SetRealTimeClock(ctx context.Context, t time.Time) (err error) {
+	sb := make([]byte, 512)
+	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
+	sb[4] = byte(OpTIME)
+	sb[5] = byte(SpaceSNES)
+	sb[6] = byte(FlagNONE)
+
+	t = t.Local()
+	sb[256] = byte(t.Second())
+	sb[257] = byte(t.Minute())
+	sb[258] = byte(t.Hour())
+	sb[259] = byte(t.Day())
+	sb[260] = byte(t.Month())
+	binary.BigEndian.PutUint16(sb[261:263], uint16(t.Year()))
+	sb[263] = byte(t.Weekday())
+
+	if shouldLock(ctx) {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+	}
+
+	err = sendSerialChunked(d.f, 512, sb)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	err = recvSerial(ctx, d.f, sb, 512)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
+		err = fmt.Errorf("time: fxpakpro response packet does not contain USBA header")
+		err = d.ProtocolError(err)
+		return
+	}
+	if ec := sb[5]; ec != 0 {
+		err = fmt.Errorf("time: %w", fxpakproError(ec))
+		err = d.NonFatalError(err)
+		return
+	}
+
+	return
+}
+
 func (d *Device) PauseUnpause(ctx context.Context, pausedState bool) (bool, error) {
 	panic("implement me")
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"go.bug.st/serial"
 	"sni/devices"
+	"sni/devices/snes/romhash"
 	"sync"
 )
 
@@ -12,11 +13,26 @@ type Device struct {
 	lock sync.Mutex
 	f    serial.Port
 
+	// stream is non-nil when streaming mode is enabled
+	stream *streamer
+
+	romHash romhash.Cache
+
 	isClosed bool
 }
 
 func (d *Device) FatalError(cause error) devices.DeviceError {
-	return devices.DeviceFatal(fmt.Sprintf("fxpakpro: %v", cause), cause)
+	kind := devices.KindOf(cause)
+	if kind == devices.ErrorKindUnknown {
+		// fatal errors come from the serial port so treat them as a lost connection:
+		kind = devices.ErrorKindDisconnected
+	}
+	return devices.DeviceFatalKind(kind, fmt.Sprintf("fxpakpro: %v", cause), cause)
+}
+
+// ProtocolError is fatal since the USB protocol state can no longer be trusted
+func (d *Device) ProtocolError(cause error) devices.DeviceError {
+	return devices.DeviceFatalKind(devices.ErrorKindProtocolViolation, fmt.Sprintf("fxpakpro: %v", cause), cause)
 }
 
 func (d *Device) NonFatalError(cause error) devices.DeviceError {
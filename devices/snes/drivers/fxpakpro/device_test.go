@@ -62,6 +62,16 @@ func openExactDevice(tb testing.TB) *Device {
 	return d
 }
 
+// openSimulatedDevice opens a device connected to its own fresh Simulator
+func openSimulatedDevice(tb testing.TB) (*Device, *Simulator) {
+	sim := NewSimulator()
+	d := &Device{f: sim.Open()}
+	if err := d.Init(); err != nil {
+		tb.Fatal(err)
+	}
+	return d, sim
+}
+
 func BenchmarkMemory(b *testing.B) {
 	var err error
 	d := openAutoCloseableDevice(b)
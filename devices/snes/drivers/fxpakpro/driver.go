@@ -18,6 +18,8 @@ import (
 
 const (
 	driverName = "fxpakpro"
+	// simHost is the uri host of the in-process simulator, e.g. `fxpakpro://sim`
+	simHost = "sim"
 )
 
 var driver *Driver
@@ -44,6 +46,9 @@ const defaultAddressSpace = sni.AddressSpace_FxPakPro
 type Driver struct {
 	container devices.DeviceContainer
 	enumLock  sync.Mutex
+
+	simOnce sync.Once
+	sim     *Simulator
 }
 
 func (d *Driver) DisplayOrder() int {
@@ -65,6 +70,8 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_WriteMemory,
 	sni.DeviceCapability_ResetSystem,
 	sni.DeviceCapability_ResetToMenu,
+	sni.DeviceCapability_PowerCycle,
+	sni.DeviceCapability_SetRealTimeClock,
 	sni.DeviceCapability_ExecuteASM,
 	sni.DeviceCapability_FetchFields,
 	// filesystem:
@@ -124,10 +131,29 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 		}
 	}
 
+	if config.Config.GetBool("fxpakpro_sim") {
+		devs = append(devs, devices.DeviceDescriptor{
+			Uri:                 url.URL{Scheme: driverName, Host: simHost},
+			DisplayName:         "FX Pak Pro Simulator",
+			Kind:                d.Kind(),
+			Capabilities:        driverCapabilities[:],
+			DefaultAddressSpace: defaultAddressSpace,
+			System:              "snes",
+		})
+	}
+
 	err = nil
 	return
 }
 
+// Simulator returns the in-process simulator shared by all `fxpakpro://sim` connections
+func (d *Driver) Simulator() *Simulator {
+	d.simOnce.Do(func() {
+		d.sim = NewSimulator()
+	})
+	return d.sim
+}
+
 func (d *Driver) openPort(portName string, baudRequest int) (f serial.Port, err error) {
 	f = serial.Port(nil)
 
@@ -166,6 +192,10 @@ func (d *Driver) openPort(portName string, baudRequest int) (f serial.Port, err
 }
 
 func (d *Driver) DeviceKey(uri *url.URL) (key string) {
+	if uri.Host == simHost {
+		return simHost
+	}
+
 	key = uri.Path
 	// macos/linux paths:
 	if strings.HasPrefix(key, "/dev/") {
@@ -181,6 +211,17 @@ func (d *Driver) DeviceKey(uri *url.URL) (key string) {
 }
 
 func (d *Driver) openDevice(uri *url.URL) (device devices.Device, err error) {
+	if uri.Host == simHost {
+		dev := &Device{f: d.Simulator().Open()}
+		if config.Config.GetBool("fxpakpro_stream") {
+			dev.enableStream()
+		}
+		err = dev.Init()
+
+		device = dev
+		return
+	}
+
 	portName := uri.Path
 
 	var baudRequest int
@@ -199,6 +240,7 @@ func (d *Driver) openDevice(uri *url.URL) (device devices.Device, err error) {
 		return
 	}
 
+	// the STREAM layout is only implemented by the simulator and has not been verified against firmware:
 	dev := &Device{f: f}
 	err = dev.Init()
 
@@ -233,6 +275,9 @@ func DriverInit() {
 	}
 
 	log.Println("Enabling fxpakpro snes driver")
+	if config.Config.GetBool("fxpakpro_stream") {
+		log.Printf("fxpakpro: streaming is only enabled for %s://%s; other devices are polled\n", driverName, simHost)
+	}
 
 	driver = &Driver{}
 	driver.container = devices.NewDeviceDriverContainer(driver.openDevice)
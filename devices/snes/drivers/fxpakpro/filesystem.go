@@ -23,6 +23,8 @@ func (d *Device) RenameFile(ctx context.Context, path, newFilename string) error
 }
 
 func (d *Device) PutFile(ctx context.Context, path string, size uint32, r io.Reader, progress devices.ProgressReportFunc) (n uint32, err error) {
+	// the file may replace the loaded ROM under the same name:
+	d.romHash.Reset()
 	n, err = d.putFile(ctx, path, size, r, progress)
 	return
 }
@@ -33,5 +35,6 @@ func (d *Device) GetFile(ctx context.Context, path string, w io.Writer, sizeRece
 }
 
 func (d *Device) BootFile(ctx context.Context, path string) error {
+	d.romHash.Reset()
 	return d.boot(ctx, path)
 }
@@ -55,12 +55,12 @@ func (d *Device) get(ctx context.Context, space space, address uint32, size uint
 	}
 	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
 		err = fmt.Errorf("get: fxpakpro response packet does not contain USBA header")
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if sb[4] != byte(OpRESPONSE) {
 		err = fmt.Errorf("get: wrong opcode in response packet; got $%02x", sb[4])
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if ec := sb[5]; ec != 0 {
@@ -70,10 +70,7 @@ func (d *Device) get(ctx context.Context, space space, address uint32, size uint
 	}
 
 	// read the response:
-	paddedSize := size
-	if size&511 != 0 {
-		paddedSize = size&512 + 512
-	}
+	paddedSize := (size + 511) &^ 511
 
 	data = make([]byte, paddedSize)
 	err = recvSerial(ctx, d.f, data, paddedSize)
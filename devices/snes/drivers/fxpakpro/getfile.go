@@ -39,12 +39,12 @@ func (d *Device) getFile(ctx context.Context, path string, w io.Writer, sizeRece
 	}
 	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
 		received, err = 0, fmt.Errorf("getFile: response packet does not contain USBA header")
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if sb[4] != byte(OpRESPONSE) {
 		received, err = 0, fmt.Errorf("getFile: wrong opcode in response packet; got $%02x", sb[4])
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if ec := sb[5]; ec != 0 {
@@ -3,15 +3,18 @@ package fxpakpro
 import (
 	"context"
 	"fmt"
+	"sni/devices/snes/romhash"
 	"sni/protos/sni"
+	"strings"
 )
 
 func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error) {
 	var version string
 	var deviceName string
 	var rom string
+	var flags info_flags
 
-	version, deviceName, rom, err = d.info(ctx)
+	version, deviceName, rom, flags, err = d.info(ctx)
 	if err != nil {
 		return
 	}
@@ -27,6 +30,25 @@ func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 		case sni.Field_RomFileName:
 			values = append(values, rom)
 			break
+		case sni.Field_RomHashType:
+			values = append(values, romhash.Type())
+			break
+		case sni.Field_RomHashValue:
+			var hashValue string
+			_, hashValue, err = d.romHash.Get(ctx, rom, func(ctx context.Context) ([]byte, error) {
+				return romhash.ReadROM(ctx, d)
+			})
+			if err != nil {
+				return
+			}
+			values = append(values, hashValue)
+			break
+		case sni.Field_DeviceFeatures:
+			values = append(values, strings.Join((flags&^cartridgeFeatures).Names(), ","))
+			break
+		case sni.Field_CartridgeFeatures:
+			values = append(values, strings.Join((flags&cartridgeFeatures).Names(), ","))
+			break
 		default:
 			// unknown value; append empty string to maintain index association:
 			values = append(values, "")
@@ -37,7 +59,7 @@ func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 	return
 }
 
-func (d *Device) info(ctx context.Context) (version, device, rom string, err error) {
+func (d *Device) info(ctx context.Context) (version, device, rom string, flags info_flags, err error) {
 	sb := make([]byte, 512)
 	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
 	sb[4] = byte(OpINFO)
@@ -64,12 +86,12 @@ func (d *Device) info(ctx context.Context) (version, device, rom string, err err
 	}
 	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
 		err = fmt.Errorf("info: fxpakpro response packet does not contain USBA header")
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if sb[4] != byte(OpRESPONSE) {
 		err = fmt.Errorf("info: wrong opcode in response packet; got $%02x", sb[4])
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if ec := sb[5]; ec != 0 {
@@ -78,6 +100,8 @@ func (d *Device) info(ctx context.Context) (version, device, rom string, err err
 		return
 	}
 
+	flags = info_flags(sb[6])
+
 	romB := sb[16:252]
 	rom = string(romB[:clen(romB)])
 
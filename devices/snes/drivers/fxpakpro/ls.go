@@ -39,19 +39,19 @@ func (d *Device) listFiles(ctx context.Context, path string) (files []devices.Di
 
 	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
 		files, err = nil, fmt.Errorf("ls: fxpakpro response packet does not contain USBA header")
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 
 	// fxpakpro `ls` command always returns 1 for size:
 	if size := binary.BigEndian.Uint32(sb[252:256]); size != 1 {
 		files, err = nil, fmt.Errorf("ls: fxpakpro response size actual %d, expected 1", size)
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if sb[4] != byte(OpRESPONSE) {
 		files, err = nil, fmt.Errorf("ls: wrong opcode in response packet; got $%02x", sb[4])
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if ec := sb[5]; ec != 0 {
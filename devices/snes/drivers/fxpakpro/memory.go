@@ -72,6 +72,11 @@ func (d *Device) MultiReadMemory(
 		}
 	}
 
+	// reads of watched ranges are answered from the stream's shadow copy without a USB round trip:
+	if d.stream != nil && d.stream.fill(mrsp) {
+		return
+	}
+
 	subctx := ctx
 	if shouldLock(ctx) {
 		d.lock.Lock()
@@ -137,6 +142,11 @@ func (d *Device) MultiReadMemory(
 		}
 	}
 
+	// watch the WRAM and SRAM ranges just read and restart the stream to keep them up to date:
+	if d.stream != nil && shouldLock(ctx) && d.stream.watch(mrsp) {
+		err = d.startStream(subctx)
+	}
+
 	return
 }
 
@@ -270,82 +280,91 @@ func (d *Device) MultiWriteMemory(
 			)
 		}
 
-		chunks := make([]vputChunk, 0, 8)
-		startAddr := uint32(0x2C00)
-		addr := startAddr
-		data := code[:a.Len()]
-		size := len(data)
-		for size > 0 {
-			chunkSize := 255
-			if size < chunkSize {
-				chunkSize = size
-			}
+		_, err = d.executeUSBEXE(subctx, code[:a.Len()], true)
+		if err != nil {
+			return
+		}
+	}
 
-			// 4-byte struct: 1 byte size, 3 byte address
-			chunks = append(chunks, vputChunk{
-				addr: addr,
-				// target offset to write to in Data[] for MemoryWriteResponse:
-				data: data[int(addr-startAddr) : int(addr-startAddr)+chunkSize],
-			})
+	return
+}
 
-			size -= 255
-			addr += 255
+// executeUSBEXE uploads code to the USB EXE buffer at $2C00 in CMD space to be executed during the next NMI.
+// The code is responsible for clearing $2C00 to signal completion. If awaitCompletion is true, this waits for
+// the code to complete executing.
+func (d *Device) executeUSBEXE(ctx context.Context, code []byte, awaitCompletion bool) (completed bool, err error) {
+	chunks := make([]vputChunk, 0, 8)
+	startAddr := uint32(0x2C00)
+	addr := startAddr
+	size := len(code)
+	for size > 0 {
+		chunkSize := 255
+		if size < chunkSize {
+			chunkSize = size
 		}
 
-		if actual, expected := len(chunks), 8; actual > expected {
-			return nil, fmt.Errorf(
-				"fxpakpro: too many VPUT chunks to write WRAM data with; %d > %d",
-				actual,
-				expected,
-			)
-		}
+		// 4-byte struct: 1 byte size, 3 byte address
+		chunks = append(chunks, vputChunk{
+			addr: addr,
+			data: code[int(addr-startAddr) : int(addr-startAddr)+chunkSize],
+		})
 
-		// await 5 seconds in game-frames for USB EXE:
-		awaitctx, awaitcancel := context.WithTimeout(subctx, timing.Frame*60*5)
-
-		// VGET to await USB EXE availability:
-		{
-			var ok bool
-			ok, err = d.awaitUSBEXE(awaitctx)
-			if err != nil {
-				awaitcancel()
-				err = fmt.Errorf("fxpakpro: could not acquire USB EXE pre-write: %w", err)
-				return
-			}
-			if !ok {
-				awaitcancel()
-				err = fmt.Errorf("fxpakpro: could not acquire USB EXE pre-write")
-				return
-			}
-		}
+		size -= 255
+		addr += 255
+	}
 
-		// VPUT command to CMD space:
-		err = d.vput(awaitctx, SpaceCMD, chunks...)
+	if actual, expected := len(chunks), 8; actual > expected {
+		return false, fmt.Errorf(
+			"fxpakpro: too many VPUT chunks to write USB EXE code with; %d > %d",
+			actual,
+			expected,
+		)
+	}
+
+	// await 5 seconds in game-frames for USB EXE:
+	awaitctx, awaitcancel := context.WithTimeout(ctx, timing.Frame*60*5)
+	defer awaitcancel()
+
+	// VGET to await USB EXE availability:
+	{
+		var ok bool
+		ok, err = d.awaitUSBEXE(awaitctx)
 		if err != nil {
-			awaitcancel()
-			err = fmt.Errorf("fxpakpro: could not VPUT to USB EXE: %w", err)
+			err = fmt.Errorf("fxpakpro: could not acquire USB EXE pre-write: %w", err)
 			return
 		}
-
-		// await USB EXE availability to validate the write was completed:
-		{
-			var ok bool
-			ok, err = d.awaitUSBEXE(awaitctx)
-			if err != nil {
-				awaitcancel()
-				err = fmt.Errorf("fxpakpro: could not acquire USB EXE post-write: %w", err)
-				return
-			}
-			if !ok {
-				awaitcancel()
-				err = fmt.Errorf("fxpakpro: could not acquire USB EXE post-write")
-				return
-			}
+		if !ok {
+			err = devices.WithKind(devices.ErrorKindBusy, fmt.Errorf("fxpakpro: could not acquire USB EXE pre-write"))
+			return
 		}
+	}
+
+	// VPUT command to CMD space:
+	err = d.vput(awaitctx, SpaceCMD, chunks...)
+	if err != nil {
+		err = fmt.Errorf("fxpakpro: could not VPUT to USB EXE: %w", err)
+		return
+	}
+
+	if !awaitCompletion {
+		return
+	}
 
-		awaitcancel()
+	// await USB EXE availability to validate the write was completed:
+	{
+		var ok bool
+		ok, err = d.awaitUSBEXE(awaitctx)
+		if err != nil {
+			err = fmt.Errorf("fxpakpro: could not acquire USB EXE post-write: %w", err)
+			return
+		}
+		if !ok {
+			err = devices.WithKind(devices.ErrorKindBusy, fmt.Errorf("fxpakpro: could not acquire USB EXE post-write"))
+			return
+		}
 	}
 
+	completed = true
 	return
 }
 
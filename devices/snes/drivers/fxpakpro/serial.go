@@ -75,7 +75,7 @@ func readExact(ctx context.Context, f io.Reader, chunkSize uint32, buf []byte) (
 			attempts++
 			trace.Logf(ctx, "retry", "attempts = %v", attempts)
 			if attempts >= 15 {
-				err = fmt.Errorf("readExact: timed out after 15 attempts of reading zero bytes")
+				err = devices.WithKind(devices.ErrorKindTimeout, fmt.Errorf("readExact: timed out after 15 attempts of reading zero bytes"))
 				return
 			}
 		} else {
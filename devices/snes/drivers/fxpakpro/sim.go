@@ -0,0 +1,481 @@
+package fxpakpro
+
+import (
+	"fmt"
+	"github.com/alttpo/snes/emulator/bus"
+	"github.com/alttpo/snes/emulator/cpu65c816"
+	"github.com/alttpo/snes/emulator/memory"
+	"go.bug.st/serial"
+	"log"
+	"path"
+	"sni/devices/snes/romhash"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FatFs result codes the fxpakpro firmware reports in the error code byte of a response:
+const (
+	simErrNoFile      fxpakproError = 4
+	simErrNoPath      fxpakproError = 5
+	simErrInvalidName fxpakproError = 6
+	simErrDenied      fxpakproError = 7
+	simErrExist       fxpakproError = 8
+	simErrInvalid     fxpakproError = 19
+)
+
+const (
+	simMenuROM     = "/sd2snes/m3nu.bin"
+	simWRAMStart   = 0xF50000
+	simWRAMEnd     = 0xF70000
+	simUSBEXE      = 0x2C00
+	simMaxExecStep = 1_000_000
+)
+
+type simFile struct {
+	dir  bool
+	data []byte
+}
+
+// Simulator is an in-process FX Pak Pro which speaks the USBA protocol over a fake serial.Port. It holds an SD card
+// filesystem and the SNES memory spaces and executes USB EXE code written to $2C00 in CMD space with a 65816
+// emulator, as the real device would do during the next NMI.
+type Simulator struct {
+	lock sync.Mutex
+
+	// Memory is the SNES space addressed by the FX Pak Pro: ROM at $000000, SRAM at $E00000, WRAM at $F50000.
+	Memory [0x1000000]byte
+	// CMD is the snescmd space which holds the USB EXE buffer at $2C00.
+	CMD [0x10000]byte
+
+	Version    string
+	DeviceName string
+	Features   info_flags
+
+	romName string
+	rtc     time.Time
+	files   map[string]*simFile
+	ports   map[*simPort]struct{}
+
+	bus *bus.Bus
+	cpu cpu65c816.CPU
+}
+
+func NewSimulator() *Simulator {
+	return &Simulator{
+		Version:    "1.11.0",
+		DeviceName: "FXPAK PRO STM32",
+		Features:   FeatMSU1 | FeatCMD_UNLOCK | FeatUSB1 | FeatDMA1,
+		romName:    simMenuROM,
+		files: map[string]*simFile{
+			"/": {dir: true},
+		},
+		ports: make(map[*simPort]struct{}),
+	}
+}
+
+// Open returns a new connection to the simulator. Each connection has its own protocol state while the SD card
+// and memory are shared.
+func (s *Simulator) Open() serial.Port {
+	p := &simPort{
+		sim:     s,
+		timeout: serial.NoTimeout,
+		signal:  make(chan struct{}, 1),
+	}
+
+	s.lock.Lock()
+	s.ports[p] = struct{}{}
+	s.lock.Unlock()
+
+	return p
+}
+
+// Write changes SNES memory as if the console had written it over the bus so that streaming connections observe it
+func (s *Simulator) Write(address uint32, data []byte) {
+	s.lock.Lock()
+	for i, b := range data {
+		s.Memory[(address+uint32(i))&0xFFFFFF] = b
+	}
+	ports := make([]*simPort, 0, len(s.ports))
+	for p := range s.ports {
+		ports = append(ports, p)
+	}
+	s.lock.Unlock()
+
+	for _, p := range ports {
+		p.streamWrites(address, data)
+	}
+}
+
+func (s *Simulator) closed(p *simPort) {
+	s.lock.Lock()
+	delete(s.ports, p)
+	s.lock.Unlock()
+}
+
+func simPath(p string) string {
+	return path.Clean("/" + p)
+}
+
+func (s *Simulator) lookup(p string) (f *simFile, ec fxpakproError) {
+	p = simPath(p)
+	f, ok := s.files[p]
+	if !ok {
+		if parent, ok := s.files[path.Dir(p)]; !ok || !parent.dir {
+			return nil, simErrNoPath
+		}
+		return nil, simErrNoFile
+	}
+	return f, 0
+}
+
+func (s *Simulator) create(p string, f *simFile) fxpakproError {
+	p = simPath(p)
+	if p == "/" {
+		return simErrInvalidName
+	}
+	if parent, ok := s.files[path.Dir(p)]; !ok || !parent.dir {
+		return simErrNoPath
+	}
+	if existing, ok := s.files[p]; ok {
+		if existing.dir || f.dir {
+			return simErrExist
+		}
+	}
+	s.files[p] = f
+	return 0
+}
+
+func (s *Simulator) getFile(p string) (data []byte, ec fxpakproError) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var f *simFile
+	if f, ec = s.lookup(p); ec != 0 {
+		return
+	}
+	if f.dir {
+		return nil, simErrDenied
+	}
+	return f.data, 0
+}
+
+func (s *Simulator) putFile(p string, data []byte) fxpakproError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.create(p, &simFile{data: data})
+}
+
+func (s *Simulator) mkdir(p string) fxpakproError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.create(p, &simFile{dir: true})
+}
+
+func (s *Simulator) rm(p string) fxpakproError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	f, ec := s.lookup(p)
+	if ec != 0 {
+		return ec
+	}
+	p = simPath(p)
+	if p == "/" {
+		return simErrDenied
+	}
+	if f.dir {
+		// only empty directories may be removed:
+		prefix := p + "/"
+		for name := range s.files {
+			if strings.HasPrefix(name, prefix) {
+				return simErrDenied
+			}
+		}
+	}
+	delete(s.files, p)
+	return 0
+}
+
+// mv renames a file or directory within its current directory
+func (s *Simulator) mv(p, newFilename string) fxpakproError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if newFilename == "" || strings.ContainsRune(newFilename, '/') {
+		return simErrInvalidName
+	}
+
+	f, ec := s.lookup(p)
+	if ec != 0 {
+		return ec
+	}
+	p = simPath(p)
+	if p == "/" {
+		return simErrDenied
+	}
+	np := path.Join(path.Dir(p), newFilename)
+	if _, ok := s.files[np]; ok {
+		return simErrExist
+	}
+
+	delete(s.files, p)
+	s.files[np] = f
+	if f.dir {
+		prefix := p + "/"
+		for name, child := range s.files {
+			if strings.HasPrefix(name, prefix) {
+				delete(s.files, name)
+				s.files[np+"/"+name[len(prefix):]] = child
+			}
+		}
+	}
+	return 0
+}
+
+type simDirEntry struct {
+	name string
+	dir  bool
+}
+
+func (s *Simulator) ls(p string) (entries []simDirEntry, ec fxpakproError) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var f *simFile
+	if f, ec = s.lookup(p); ec != 0 {
+		return
+	}
+	if !f.dir {
+		return nil, simErrNoPath
+	}
+
+	p = simPath(p)
+	for name, child := range s.files {
+		if name == "/" || path.Dir(name) != p {
+			continue
+		}
+		entries = append(entries, simDirEntry{name: path.Base(name), dir: child.dir})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+	return
+}
+
+// boot loads a ROM file from the SD card into ROM memory and resets the console
+func (s *Simulator) boot(p string) fxpakproError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	f, ec := s.lookup(p)
+	if ec != 0 {
+		return ec
+	}
+	if f.dir {
+		return simErrDenied
+	}
+
+	rom := f.data
+	// strip off any copier header:
+	if len(rom)&0x3FF == 0x200 {
+		rom = rom[0x200:]
+	}
+	if len(rom) > 0xE00000 {
+		return simErrInvalid
+	}
+
+	// a ROM whose size is not a power of two is mirrored to fill out the rest:
+	n := copy(s.Memory[:0xE00000], romhash.Mirror(rom))
+	for i := n; i < 0xE00000; i++ {
+		s.Memory[i] = 0
+	}
+	s.romName = simPath(p)
+	s.reset()
+	return 0
+}
+
+func (s *Simulator) menuReset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.romName = simMenuROM
+	s.reset()
+}
+
+// powerCycle clears WRAM and the CMD space; ROM and SRAM are kept as the cart would reload the same ROM
+func (s *Simulator) powerCycle() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.reset()
+	for i := range s.CMD {
+		s.CMD[i] = 0
+	}
+}
+
+func (s *Simulator) setTime(b []byte) fxpakproError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	month := time.Month(b[4])
+	if month < time.January || month > time.December || b[3] < 1 || b[3] > 31 {
+		return simErrInvalid
+	}
+	year := int(b[5])<<8 | int(b[6])
+	s.rtc = time.Date(year, month, int(b[3]), int(b[2]), int(b[1]), int(b[0]), 0, time.Local)
+	return 0
+}
+
+func (s *Simulator) resetSystem() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.reset()
+}
+
+func (s *Simulator) reset() {
+	for i := simWRAMStart; i < simWRAMEnd; i++ {
+		s.Memory[i] = 0
+	}
+	s.CMD[simUSBEXE] = 0
+}
+
+func (s *Simulator) space(sp space) (mem []byte, ok bool) {
+	switch sp {
+	case SpaceSNES:
+		return s.Memory[:], true
+	case SpaceCMD:
+		return s.CMD[:], true
+	default:
+		return nil, false
+	}
+}
+
+func (s *Simulator) read(sp space, address uint32, dest []byte) fxpakproError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	mem, ok := s.space(sp)
+	if !ok {
+		return simErrInvalid
+	}
+	for i := range dest {
+		dest[i] = mem[(address+uint32(i))%uint32(len(mem))]
+	}
+	return 0
+}
+
+func (s *Simulator) write(sp space, address uint32, data []byte) fxpakproError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	mem, ok := s.space(sp)
+	if !ok {
+		return simErrInvalid
+	}
+	for i, b := range data {
+		mem[(address+uint32(i))%uint32(len(mem))] = b
+	}
+	return 0
+}
+
+// runUSBEXE executes the code in the USB EXE buffer if it is enabled, stopping when it jumps to the original NMI
+// handler via `JMP ($FFEA)`.
+func (s *Simulator) runUSBEXE() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.CMD[simUSBEXE] == 0 {
+		return
+	}
+
+	if s.bus == nil {
+		s.bus = s.newBus()
+	}
+
+	cpu := &s.cpu
+	cpu.Init(s.bus)
+	cpu.SetFlags(0x34)
+	cpu.SP = 0x01FF
+	cpu.PC = simUSBEXE
+
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("fxpakpro: sim: USB EXE crashed at $%02x:%04x: %v\n", cpu.RK, cpu.PC, r)
+		}
+	}()
+
+	for i := 0; i < simMaxExecStep; i++ {
+		pc := uint32(cpu.RK)<<16 | uint32(cpu.PC)
+		if s.bus.EaRead(pc) == 0x6C && s.bus.EaRead(pc+1) == 0xEA && s.bus.EaRead(pc+2) == 0xFF {
+			return
+		}
+		cpu.Step()
+		if cpu.Stopped {
+			break
+		}
+	}
+
+	log.Printf("fxpakpro: sim: USB EXE did not return to NMI handler; last PC $%02x:%04x\n", cpu.RK, cpu.PC)
+}
+
+// newBus maps WRAM, the CMD space and LoROM ROM and SRAM into the 65816 address space
+func (s *Simulator) newBus() *bus.Bus {
+	b, _ := bus.New()
+
+	attach := func(data []byte, start uint32) {
+		if err := b.Attach(memory.NewRAM(data, start), "", start, start+uint32(len(data))-1); err != nil {
+			panic(fmt.Errorf("fxpakpro: sim: %w", err))
+		}
+	}
+
+	attach(s.Memory[simWRAMStart:simWRAMEnd], 0x7E0000)
+	for _, bank := range []uint32{0x00, 0x80} {
+		attach(s.Memory[simWRAMStart:simWRAMStart+0x2000], bank<<16)
+		attach(s.CMD[0x2A00:0x3000], bank<<16|0x2A00)
+	}
+	for bank := uint32(0); bank < 0x40; bank++ {
+		rom := s.Memory[bank*0x8000 : bank*0x8000+0x8000]
+		attach(rom, bank<<16|0x8000)
+		attach(rom, (bank|0x80)<<16|0x8000)
+	}
+	for bank := uint32(0x70); bank < 0x7E; bank++ {
+		sram := 0xE00000 + (bank-0x70)*0x8000
+		attach(s.Memory[sram:sram+0x8000], bank<<16)
+	}
+
+	return b
+}
+
+func (s *Simulator) info(rsp []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	rsp[6] = byte(s.Features)
+	copy(rsp[16:252], s.romName)
+	copy(rsp[260:260+64], s.Version)
+	copy(rsp[260+64:260+64+64], s.DeviceName)
+}
+
+func simCString(b []byte) string {
+	return string(b[:clen(b)])
+}
+
+func simChunks(header []byte) (addrs []uint32, sizes []int, total int) {
+	for p := header[32:64]; len(p) >= 4; p = p[4:] {
+		size := int(p[0])
+		if size == 0 {
+			continue
+		}
+		addrs = append(addrs, uint32(p[1])<<16|uint32(p[2])<<8|uint32(p[3]))
+		sizes = append(sizes, size)
+		total += size
+	}
+	return
+}
+
+func simPadded(size, blockSize int) int {
+	return (size + blockSize - 1) / blockSize * blockSize
+}
@@ -0,0 +1,381 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/drivers/mock"
+	"sni/protos/sni"
+	"testing"
+	"time"
+)
+
+func TestSimulator_filesystem(t *testing.T) {
+	d, _ := openSimulatedDevice(t)
+	defer d.Close()
+
+	ctx := context.Background()
+
+	if err := d.MakeDirectory(ctx, "unittest"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.MakeDirectory(ctx, "unittest"); err == nil {
+		t.Fatal("expected error making existing directory")
+	}
+
+	// odd sizes exercise the 512-byte framing:
+	for _, size := range []int{0, 1, 511, 512, 513, 1100} {
+		contents := make([]byte, size)
+		for i := range contents {
+			contents[i] = byte(i * 7)
+		}
+
+		_, err := d.PutFile(ctx, "unittest/test1", uint32(size), bytes.NewReader(contents), nil)
+		if err != nil {
+			t.Fatalf("PutFile(%d) error = %v", size, err)
+		}
+
+		w := &bytes.Buffer{}
+		received, err := d.GetFile(ctx, "unittest/test1", w, nil, nil)
+		if err != nil {
+			t.Fatalf("GetFile(%d) error = %v", size, err)
+		}
+		if received != uint32(size) || !bytes.Equal(w.Bytes(), contents) {
+			t.Fatalf("GetFile(%d) received %d bytes; contents match = %v", size, received, bytes.Equal(w.Bytes(), contents))
+		}
+	}
+
+	if err := d.RenameFile(ctx, "unittest/test1", "test2"); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := d.ReadDirectory(ctx, "unittest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Name != "test2" || files[0].Type != sni.DirEntryType_File {
+		t.Fatalf("ReadDirectory = %v", files)
+	}
+
+	if err = d.RemoveFile(ctx, "unittest"); err == nil {
+		t.Fatal("expected error removing non-empty directory")
+	}
+	if err = d.RemoveFile(ctx, "unittest/test2"); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.RemoveFile(ctx, "unittest"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = d.ReadDirectory(ctx, "unittest")
+	var ec fxpakproError
+	if !errors.As(err, &ec) || ec != simErrNoFile {
+		t.Fatalf("ReadDirectory of removed directory error = %v", err)
+	}
+	if devices.IsFatal(err) {
+		t.Fatal("expected non-fatal error")
+	}
+}
+
+func TestSimulator_readDirectoryPackets(t *testing.T) {
+	d, _ := openSimulatedDevice(t)
+	defer d.Close()
+
+	ctx := context.Background()
+
+	// enough long names to need several 512-byte listing packets:
+	const count = 40
+	for i := 0; i < count; i++ {
+		name := string(rune('A'+i%26)) + string(rune('a'+i/26)) + "_a_rather_long_file_name_for_testing.sfc"
+		if _, err := d.PutFile(ctx, name, 1, bytes.NewReader([]byte{1}), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := d.ReadDirectory(ctx, "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != count {
+		t.Fatalf("ReadDirectory returned %d entries, want %d", len(files), count)
+	}
+}
+
+func TestSimulator_bootFile(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	defer d.Close()
+
+	ctx := context.Background()
+
+	// a ROM with a copier header:
+	rom := make([]byte, 0x200+0x8000)
+	rom[0x200] = 0x5A
+	rom[0x200+0x7FFF] = 0xA5
+	if _, err := d.PutFile(ctx, "game.sfc", uint32(len(rom)), bytes.NewReader(rom), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.BootFile(ctx, "game.sfc"); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := d.FetchFields(ctx, sni.Field_RomFileName, sni.Field_DeviceName, sni.Field_DeviceVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"/game.sfc", sim.DeviceName, sim.Version}; values[0] != expected[0] || values[1] != expected[1] || values[2] != expected[2] {
+		t.Fatalf("FetchFields = %v, want %v", values, expected)
+	}
+
+	sim.Features = FeatSRTC | FeatMSU1 | FeatCMD_UNLOCK | FeatDMA1
+	if values, err = d.FetchFields(ctx, sni.Field_DeviceFeatures, sni.Field_CartridgeFeatures); err != nil {
+		t.Fatal(err)
+	}
+	if values[0] != "CMD_UNLOCK,DMA1" || values[1] != "SRTC,MSU1" {
+		t.Fatalf("FetchFields features = %v", values)
+	}
+
+	rsp, err := d.MultiReadMemory(ctx, devices.MemoryReadRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:       0x008000,
+			AddressSpace:  sni.AddressSpace_SnesABus,
+			MemoryMapping: sni.MemoryMapping_LoROM,
+		},
+		Size: 0x8000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data := rsp[0].Data; data[0] != 0x5A || data[0x7FFF] != 0xA5 {
+		t.Fatalf("ROM read $%02x..$%02x", data[0], data[0x7FFF])
+	}
+
+	if err = d.ResetToMenu(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if values, err = d.FetchFields(ctx, sni.Field_RomFileName); err != nil {
+		t.Fatal(err)
+	}
+	if values[0] != simMenuROM {
+		t.Fatalf("RomFileName after ResetToMenu = %q", values[0])
+	}
+}
+
+func TestSimulator_romHash(t *testing.T) {
+	d, _ := openSimulatedDevice(t)
+	defer d.Close()
+
+	config.Config.Set("rom_hash_type", "sha256")
+	defer config.Config.Set("rom_hash_type", "crc32")
+
+	ctx := context.Background()
+
+	// a 64KiB LoROM with a valid header:
+	rom := mock.LoROMImage("SNI ROM HASH TEST", 0x10000)
+	if _, err := d.PutFile(ctx, "hash.sfc", uint32(len(rom)), bytes.NewReader(rom), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.BootFile(ctx, "hash.sfc"); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := d.FetchFields(ctx, sni.Field_RomHashType, sni.Field_RomHashValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(rom)
+	if expected := hex.EncodeToString(sum[:]); values[0] != "sha256" || values[1] != expected {
+		t.Fatalf("FetchFields = %v, want [sha256 %s]", values, expected)
+	}
+
+	// a regenerated ROM uploaded under the same name must not keep the old hash:
+	rom[0] ^= 0xFF
+	if _, err = d.PutFile(ctx, "hash.sfc", uint32(len(rom)), bytes.NewReader(rom), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.BootFile(ctx, "hash.sfc"); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err = d.FetchFields(ctx, sni.Field_RomHashValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum = sha256.Sum256(rom)
+	if expected := hex.EncodeToString(sum[:]); values[0] != expected {
+		t.Fatalf("FetchFields after regenerating = %v, want [%s]", values, expected)
+	}
+}
+
+func TestSimulator_get(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	defer d.Close()
+
+	for i := 0; i < 0x1000; i++ {
+		sim.Memory[0xE00000+i] = byte(i)
+	}
+
+	data, err := d.get(context.Background(), SpaceSNES, 0xE00000, 1100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, sim.Memory[0xE00000:0xE00000+1100]) {
+		t.Fatal("GET data mismatch")
+	}
+}
+
+func TestSimulator_writeWRAM(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	defer d.Close()
+
+	ctx := context.Background()
+
+	data := make([]byte, 300)
+	for i := range data {
+		data[i] = byte(i + 1)
+	}
+
+	// WRAM writes go through USB EXE and SRAM writes go through VPUT:
+	_, err := d.MultiWriteMemory(
+		ctx,
+		devices.MemoryWriteRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       0x7EF340,
+				AddressSpace:  sni.AddressSpace_SnesABus,
+				MemoryMapping: sni.MemoryMapping_LoROM,
+			},
+			Data: data,
+		},
+		devices.MemoryWriteRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       0xE00010,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: sni.MemoryMapping_LoROM,
+			},
+			Data: []byte{0x12, 0x34},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual := sim.Memory[0xF5F340 : 0xF5F340+len(data)]; !bytes.Equal(actual, data) {
+		t.Fatalf("WRAM = %v, want %v", actual, data)
+	}
+	if actual := sim.Memory[0xE00010:0xE00012]; !bytes.Equal(actual, []byte{0x12, 0x34}) {
+		t.Fatalf("SRAM = %v", actual)
+	}
+	if sim.CMD[0x2C00] != 0 {
+		t.Fatal("USB EXE did not complete")
+	}
+}
+
+func TestSimulator_ExecuteASM(t *testing.T) {
+	d, _ := openSimulatedDevice(t)
+	defer d.Close()
+
+	ctx := context.Background()
+
+	// SEP #$20; LDA #$55; STA $7E:FFFE; RTL
+	code := []byte{0xE2, 0x20, 0xA9, 0x55, 0x8F, 0xFE, 0xFF, 0x7E, 0x6B}
+
+	completed, rsp, err := d.ExecuteASM(ctx, code, true, devices.MemoryReadRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:       0xF5FFFE,
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: sni.MemoryMapping_LoROM,
+		},
+		Size: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !completed {
+		t.Fatal("expected completed")
+	}
+	if rsp[0].Data[0] != 0x55 {
+		t.Fatalf("expected $55 got $%02x", rsp[0].Data[0])
+	}
+}
+
+func TestSimulator_CompareAndWriteMemory(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	defer d.Close()
+
+	ctx := context.Background()
+	address := devices.AddressTuple{
+		Address:       0x7EF340,
+		AddressSpace:  sni.AddressSpace_SnesABus,
+		MemoryMapping: sni.MemoryMapping_LoROM,
+	}
+
+	sim.Memory[0xF5F340] = 0x01
+	rsp, err := d.CompareAndWriteMemory(ctx, devices.MemoryCompareAndWriteRequest{
+		RequestAddress: address,
+		Compare:        []byte{0x02},
+		Data:           []byte{0x03},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Written || sim.Memory[0xF5F340] != 0x01 {
+		t.Fatal("expected compare mismatch to not write")
+	}
+
+	rsp, err = d.CompareAndWriteMemory(ctx, devices.MemoryCompareAndWriteRequest{
+		RequestAddress: address,
+		Compare:        []byte{0x01},
+		Data:           []byte{0x03},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rsp.Written || sim.Memory[0xF5F340] != 0x03 {
+		t.Fatal("expected compare match to write")
+	}
+}
+
+func TestSimulator_unsupportedOpcode(t *testing.T) {
+	d, _ := openSimulatedDevice(t)
+	defer d.Close()
+
+	sb := make([]byte, 512)
+	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
+	sb[4] = 0x7F
+	if err := sendSerial(d.f, sb); err != nil {
+		t.Fatal(err)
+	}
+	if err := recvSerial(context.Background(), d.f, sb, 512); err != nil {
+		t.Fatal(err)
+	}
+	if sb[4] != byte(OpRESPONSE) || sb[5] == 0 {
+		t.Fatalf("expected error response; got opcode $%02x error code %d", sb[4], sb[5])
+	}
+}
+
+func TestSimulator_powerControl(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	defer d.Close()
+
+	ctx := context.Background()
+
+	sim.Memory[0xF50010] = 0x11
+	sim.CMD[0x2A00] = 0x22
+	if err := d.PowerCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if sim.Memory[0xF50010] != 0 || sim.CMD[0x2A00] != 0 {
+		t.Fatal("expected power cycle to clear WRAM and CMD")
+	}
+
+	expected := time.Date(2024, time.February, 29, 23, 59, 58, 0, time.Local)
+	if err := d.SetRealTimeClock(ctx, expected); err != nil {
+		t.Fatal(err)
+	}
+	if !sim.rtc.Equal(expected) {
+		t.Fatalf("rtc = %v, want %v", sim.rtc, expected)
+	}
+}
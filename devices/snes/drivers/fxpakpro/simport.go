@@ -0,0 +1,446 @@
+package fxpakpro
+
+import (
+	"encoding/binary"
+	"go.bug.st/serial"
+	"os"
+	"sync"
+	"time"
+)
+
+// simTransfer is a data phase which follows a command header
+type simTransfer struct {
+	size     int
+	complete func(data []byte)
+}
+
+// simPort implements serial.Port as a single connection to a Simulator. Writes are parsed as USBA command headers
+// followed by any data phase; responses are queued up for Read.
+type simPort struct {
+	sim *Simulator
+
+	lock    sync.Mutex
+	in      []byte
+	out     []byte
+	pending *simTransfer
+	timeout time.Duration
+	closed  bool
+
+	// streaming holds the ranges watched by an active STREAM
+	streaming []simStreamRange
+
+	signal chan struct{}
+}
+
+type simStreamRange struct {
+	addr uint32
+	size int
+}
+
+func (p *simPort) SetMode(mode *serial.Mode) error { return nil }
+
+func (p *simPort) Read(b []byte) (n int, err error) {
+	p.lock.Lock()
+	timeout := p.timeout
+	p.lock.Unlock()
+
+	var timer <-chan time.Time
+	if timeout >= 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		timer = t.C
+	}
+
+	for {
+		p.lock.Lock()
+		if p.closed {
+			p.lock.Unlock()
+			return 0, os.ErrClosed
+		}
+		if len(p.out) > 0 {
+			n = copy(b, p.out)
+			p.out = p.out[n:]
+			p.lock.Unlock()
+			return
+		}
+		p.lock.Unlock()
+
+		// like the real serial port, a timeout is reported as a zero-byte read:
+		select {
+		case <-p.signal:
+		case <-timer:
+			return 0, nil
+		}
+	}
+}
+
+func (p *simPort) Write(b []byte) (n int, err error) {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return 0, os.ErrClosed
+	}
+	p.in = append(p.in, b...)
+	p.process()
+	p.lock.Unlock()
+
+	p.notify()
+	return len(b), nil
+}
+
+func (p *simPort) notify() {
+	select {
+	case p.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (p *simPort) Drain() error { return nil }
+
+func (p *simPort) ResetInputBuffer() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.out = nil
+	return nil
+}
+
+func (p *simPort) ResetOutputBuffer() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.in = nil
+	p.pending = nil
+	return nil
+}
+
+func (p *simPort) SetDTR(dtr bool) error { return nil }
+
+func (p *simPort) SetRTS(rts bool) error { return nil }
+
+func (p *simPort) GetModemStatusBits() (*serial.ModemStatusBits, error) {
+	return &serial.ModemStatusBits{}, nil
+}
+
+func (p *simPort) SetReadTimeout(t time.Duration) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.timeout = t
+	return nil
+}
+
+func (p *simPort) Close() error {
+	p.lock.Lock()
+	p.closed = true
+	p.lock.Unlock()
+
+	p.sim.closed(p)
+	p.notify()
+	return nil
+}
+
+func (p *simPort) Break(time.Duration) error { return nil }
+
+// process consumes as many complete command headers and data phases as have been written so far
+func (p *simPort) process() {
+	for {
+		if p.pending != nil {
+			if len(p.in) < p.pending.size {
+				return
+			}
+			data := p.in[:p.pending.size]
+			p.in = p.in[p.pending.size:]
+			t := p.pending
+			p.pending = nil
+			t.complete(data)
+			continue
+		}
+
+		if len(p.in) < 7 {
+			return
+		}
+		blockSize := 512
+		if server_flags(p.in[6])&FlagDATA64B != 0 {
+			blockSize = 64
+		}
+		if len(p.in) < blockSize {
+			return
+		}
+
+		header := make([]byte, blockSize)
+		copy(header, p.in)
+		p.in = p.in[blockSize:]
+
+		if header[0] != 'U' || header[1] != 'S' || header[2] != 'B' || header[3] != 'A' {
+			// the firmware ignores anything which is not a command:
+			continue
+		}
+		if p.streaming != nil {
+			// any command ends an active stream:
+			p.endStream()
+		}
+		p.command(header)
+	}
+}
+
+// response creates a response header sized the same as the command header
+func (p *simPort) response(header []byte, ec fxpakproError, size uint32) []byte {
+	rsp := make([]byte, len(header))
+	rsp[0], rsp[1], rsp[2], rsp[3] = byte('U'), byte('S'), byte('B'), byte('A')
+	rsp[4] = byte(OpRESPONSE)
+	rsp[5] = byte(ec)
+	if len(rsp) == 512 {
+		binary.BigEndian.PutUint32(rsp[252:256], size)
+	}
+	return rsp
+}
+
+func (p *simPort) respond(header []byte, ec fxpakproError, size uint32) {
+	p.out = append(p.out, p.response(header, ec, size)...)
+}
+
+// send queues data padded to a multiple of the block size
+func (p *simPort) send(data []byte, blockSize int) {
+	padded := make([]byte, simPadded(len(data), blockSize))
+	copy(padded, data)
+	p.out = append(p.out, padded...)
+}
+
+func (p *simPort) command(header []byte) {
+	s := p.sim
+	blockSize := len(header)
+	op := opcode(header[4])
+	sp := space(header[5])
+	flags := server_flags(header[6])
+
+	// 64-byte headers only carry VGET/VPUT chunk lists:
+	var size, address uint32
+	var name string
+	if blockSize == 512 {
+		size = binary.BigEndian.Uint32(header[252:256])
+		address = binary.BigEndian.Uint32(header[256:260])
+		name = simCString(header[256:])
+	}
+
+	switch op {
+	case OpGET:
+		if sp == SpaceFILE {
+			data, ec := s.getFile(name)
+			p.respond(header, ec, uint32(len(data)))
+			if ec == 0 {
+				p.send(data, blockSize)
+			}
+			return
+		}
+
+		data := make([]byte, size)
+		ec := s.read(sp, address, data)
+		p.respond(header, ec, size)
+		if ec == 0 {
+			p.send(data, blockSize)
+		}
+
+	case OpPUT:
+		if sp == SpaceFILE {
+			ec := s.putFile(name, nil)
+			p.respond(header, ec, size)
+			if ec != 0 {
+				return
+			}
+			// an empty file is still followed by one block of data:
+			padded := simPadded(int(size), blockSize)
+			if size == 0 {
+				padded = blockSize
+			}
+			p.pending = &simTransfer{
+				size: padded,
+				complete: func(data []byte) {
+					s.putFile(name, append([]byte(nil), data[:size]...))
+				},
+			}
+			return
+		}
+
+		if _, ok := s.space(sp); !ok {
+			p.respond(header, simErrInvalid, size)
+			return
+		}
+		if flags&FlagNORESP == 0 {
+			p.respond(header, 0, size)
+		}
+		p.pending = &simTransfer{
+			size: simPadded(int(size), blockSize),
+			complete: func(data []byte) {
+				s.write(sp, address, data[:size])
+				if sp == SpaceCMD {
+					s.runUSBEXE()
+				}
+			},
+		}
+
+	case OpVGET:
+		addrs, sizes, total := simChunks(header)
+		data := make([]byte, 0, total)
+		ec := fxpakproError(0)
+		for i := range addrs {
+			chunk := make([]byte, sizes[i])
+			if ec = s.read(sp, addrs[i], chunk); ec != 0 {
+				break
+			}
+			data = append(data, chunk...)
+		}
+		if flags&FlagNORESP == 0 {
+			p.respond(header, ec, uint32(total))
+		}
+		if ec == 0 {
+			p.send(data, blockSize)
+		}
+
+	case OpVPUT:
+		addrs, sizes, total := simChunks(header)
+		if flags&FlagNORESP == 0 {
+			p.respond(header, 0, uint32(total))
+		}
+		p.pending = &simTransfer{
+			size: simPadded(total, blockSize),
+			complete: func(data []byte) {
+				for i := range addrs {
+					s.write(sp, addrs[i], data[:sizes[i]])
+					data = data[sizes[i]:]
+				}
+				if sp == SpaceCMD {
+					s.runUSBEXE()
+				}
+			},
+		}
+
+	case OpLS:
+		entries, ec := s.ls(name)
+		// LS always responds with a size of 1:
+		p.respond(header, ec, 1)
+		if ec != 0 {
+			return
+		}
+		p.sendListing(entries)
+
+	case OpSTREAM:
+		addrs, sizes, total := simChunks(header)
+		if blockSize != 64 || sp != SpaceSNES {
+			p.respond(header, simErrInvalid, 0)
+			return
+		}
+		if len(addrs) == 0 {
+			// stopping a stream that is not active is ignored:
+			return
+		}
+		p.respond(header, 0, uint32(total))
+
+		p.streaming = make([]simStreamRange, 0, len(addrs))
+		data := make([]byte, 0, total)
+		for i := range addrs {
+			p.streaming = append(p.streaming, simStreamRange{addr: addrs[i], size: sizes[i]})
+			chunk := make([]byte, sizes[i])
+			s.read(sp, addrs[i], chunk)
+			data = append(data, chunk...)
+		}
+		if flags&FlagSTREAM_BURST != 0 {
+			p.send(data, blockSize)
+		}
+
+	case OpMKDIR:
+		p.respond(header, s.mkdir(name), 0)
+	case OpRM:
+		p.respond(header, s.rm(name), 0)
+	case OpMV:
+		p.respond(header, s.mv(name, simCString(header[8:256])), 0)
+	case OpBOOT:
+		p.respond(header, s.boot(name), 0)
+
+	case OpRESET:
+		s.resetSystem()
+		p.respond(header, 0, 0)
+	case OpMENU_RESET:
+		s.menuReset()
+		p.respond(header, 0, 0)
+	case OpPOWER_CYCLE:
+		s.powerCycle()
+		p.respond(header, 0, 0)
+	case OpTIME:
+		p.respond(header, s.setTime(header[256:264]), 0)
+
+	case OpINFO:
+		rsp := p.response(header, 0, 0)
+		s.info(rsp)
+		p.out = append(p.out, rsp...)
+
+	default:
+		p.respond(header, simErrInvalid, 0)
+	}
+}
+
+// sendListing queues 512-byte packets of directory entries; 0x02 continues in the next packet and 0xFF ends the list
+func (p *simPort) sendListing(entries []simDirEntry) {
+	packet := make([]byte, 0, 512)
+	for _, e := range entries {
+		record := make([]byte, 0, len(e.name)+2)
+		if e.dir {
+			record = append(record, byte(FtDIRECTORY))
+		} else {
+			record = append(record, byte(FtFILE))
+		}
+		record = append(record, e.name...)
+		record = append(record, 0)
+
+		// leave room for the continuation marker:
+		if len(packet)+len(record) > 511 {
+			packet = append(packet, 2)
+			p.send(packet, 512)
+			packet = packet[:0]
+		}
+		packet = append(packet, record...)
+	}
+	packet = append(packet, 0xFF)
+	p.send(packet, 512)
+}
+
+func (p *simPort) streamFrame(frameType streamFrameType, records []byte) {
+	frame := make([]byte, streamFrameSize)
+	frame[0], frame[1], frame[2], frame[3] = byte('U'), byte('S'), byte('B'), byte('S')
+	frame[4] = byte(frameType)
+	frame[5] = byte(len(records) / 4)
+	copy(frame[streamRecordsOffset:], records)
+	p.out = append(p.out, frame...)
+}
+
+func (p *simPort) endStream() {
+	p.streaming = nil
+	p.streamFrame(streamFrameEnd, nil)
+}
+
+// streamWrites sends frames for the bytes written within the watched ranges
+func (p *simPort) streamWrites(address uint32, data []byte) {
+	p.lock.Lock()
+	if p.streaming == nil {
+		p.lock.Unlock()
+		return
+	}
+
+	records := make([]byte, 0, streamRecordsPerFrame*4)
+	for i, b := range data {
+		addr := (address + uint32(i)) & 0xFFFFFF
+		for _, r := range p.streaming {
+			if addr >= r.addr && addr < r.addr+uint32(r.size) {
+				records = append(records, byte(addr>>16), byte(addr>>8), byte(addr), b)
+				break
+			}
+		}
+		if len(records) == cap(records) {
+			p.streamFrame(streamFrameWrites, records)
+			records = records[:0]
+		}
+	}
+	if len(records) > 0 {
+		p.streamFrame(streamFrameWrites, records)
+	}
+	p.lock.Unlock()
+
+	p.notify()
+}
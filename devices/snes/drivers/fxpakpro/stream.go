@@ -0,0 +1,377 @@
+package fxpakpro
+
+import (
+	"context"
+	"fmt"
+	"go.bug.st/serial"
+	"log"
+	"sni/devices"
+	"sync"
+	"time"
+)
+
+// The STREAM opcode subscribes to SNES bus writes so that watched WRAM and SRAM ranges can be kept up to date
+// without polling:
+//
+//   - request: a 64-byte header with OpSTREAM, SpaceSNES and FlagDATA64B|FlagSTREAM_BURST. Up to 8 VGET-style
+//     chunks (1 byte size, 3 byte big-endian address) at [32:64] name the ranges to watch.
+//   - response: a 64-byte response header with the error code at [5]. With FlagSTREAM_BURST the current contents
+//     of the ranges follow, concatenated and padded to a multiple of 64 bytes.
+//   - frames: 64-byte frames follow until the stream is stopped. [0:4] = "USBS", [4] = frame type,
+//     [5] = record count, [8:64] = up to 14 records of a 3 byte big-endian address and the byte written to it.
+//   - stop: a 64-byte header with OpSTREAM and no chunks. The device finishes with an end frame. Sending any other
+//     command also ends the stream.
+//
+// This layout has only been exercised against the Simulator in simport.go and not against firmware, so streaming is
+// only enabled for the simulator device. If the end frame never arrives the port is closed, so a device which does
+// not stream as described is dropped and reopened rather than having frames interleaved with command replies.
+const (
+	streamFrameSize       = 64
+	streamRecordsOffset   = 8
+	streamRecordsPerFrame = (streamFrameSize - streamRecordsOffset) / 4
+	streamMaxRanges       = 8
+	streamMaxRangeSize    = 255
+	streamStopTimeout     = time.Second * 2
+)
+
+type streamFrameType uint8
+
+const (
+	streamFrameWrites streamFrameType = iota
+	streamFrameEnd
+)
+
+// streamWatchable reports if the FX Pak Pro address range is in WRAM or SRAM where bus writes can be observed
+func streamWatchable(addr uint32, size int) bool {
+	end := addr + uint32(size)
+	if addr >= 0xF50000 && end <= 0xF70000 {
+		return true
+	}
+	if addr >= 0xE00000 && end <= 0xF00000 {
+		return true
+	}
+	return false
+}
+
+type streamRange struct {
+	addr     uint32
+	data     []byte
+	lastUsed time.Time
+}
+
+func (r *streamRange) contains(addr uint32) bool {
+	return addr >= r.addr && addr < r.addr+uint32(len(r.data))
+}
+
+// streamer keeps shadow copies of watched memory ranges up to date from the device's STREAM frames
+type streamer struct {
+	// port is the underlying serial port which is read from by the stream goroutine while the stream is active
+	port serial.Port
+
+	lock        sync.Mutex
+	ranges      []*streamRange
+	active      bool
+	unsupported bool
+	done        chan struct{}
+}
+
+// streamPort stops any active stream before a command is written so that replies are not interleaved with frames
+type streamPort struct {
+	serial.Port
+	s *streamer
+}
+
+func (p *streamPort) Write(b []byte) (n int, err error) {
+	if err = p.s.stop(); err != nil {
+		return
+	}
+	return p.Port.Write(b)
+}
+
+// enableStream makes MultiReadMemory watch the WRAM and SRAM ranges it reads and answer later reads of them from
+// a shadow copy maintained by the STREAM opcode
+func (d *Device) enableStream() {
+	d.stream = &streamer{port: d.f}
+	d.f = &streamPort{Port: d.f, s: d.stream}
+}
+
+// find returns the watched range which contains addr
+func (s *streamer) find(addr uint32) *streamRange {
+	for _, r := range s.ranges {
+		if r.contains(addr) {
+			return r
+		}
+	}
+	return nil
+}
+
+// fill copies watched memory into the responses if the stream is active and covers every read
+func (s *streamer) fill(mrsp []devices.MemoryReadResponse) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.active {
+		return false
+	}
+
+	// make sure every byte is covered before copying anything:
+	for j := range mrsp {
+		addr, size := mrsp[j].DeviceAddress.Address, uint32(len(mrsp[j].Data))
+		for o := uint32(0); o < size; {
+			r := s.find(addr + o)
+			if r == nil {
+				return false
+			}
+			o += r.addr + uint32(len(r.data)) - (addr + o)
+		}
+	}
+
+	now := time.Now()
+	for j := range mrsp {
+		addr, data := mrsp[j].DeviceAddress.Address, mrsp[j].Data
+		for o := 0; o < len(data); {
+			r := s.find(addr + uint32(o))
+			o += copy(data[o:], r.data[addr+uint32(o)-r.addr:])
+			r.lastUsed = now
+		}
+	}
+
+	return true
+}
+
+// watch adds the WRAM and SRAM ranges of the responses to the watched ranges, evicting the least recently used
+// ranges as needed. It returns true if any of the responses are watchable.
+func (s *streamer) watch(mrsp []devices.MemoryReadResponse) (watchable bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.unsupported {
+		return false
+	}
+
+	now := time.Now()
+	added := make([]*streamRange, 0, streamMaxRanges)
+	for j := range mrsp {
+		addr, data := mrsp[j].DeviceAddress.Address, mrsp[j].Data
+		if !streamWatchable(addr, len(data)) {
+			continue
+		}
+		watchable = true
+
+		for len(data) > 0 {
+			n := len(data)
+			if n > streamMaxRangeSize {
+				n = streamMaxRangeSize
+			}
+			if r := s.find(addr); r == nil || !r.contains(addr+uint32(n)-1) {
+				added = append(added, &streamRange{
+					addr:     addr,
+					data:     append([]byte(nil), data[:n]...),
+					lastUsed: now,
+				})
+			} else {
+				r.lastUsed = now
+			}
+			addr += uint32(n)
+			data = data[n:]
+		}
+	}
+
+	// a request which needs more ranges than can be watched would only evict other ranges:
+	if len(added) > streamMaxRanges {
+		return false
+	}
+
+	for _, r := range added {
+		if len(s.ranges) == streamMaxRanges {
+			lru := 0
+			for i := range s.ranges {
+				if s.ranges[i].lastUsed.Before(s.ranges[lru].lastUsed) {
+					lru = i
+				}
+			}
+			s.ranges = append(s.ranges[:lru], s.ranges[lru+1:]...)
+		}
+		s.ranges = append(s.ranges, r)
+	}
+
+	return
+}
+
+// startStream starts the stream for the watched ranges if it is not already active. The device lock must be held.
+func (d *Device) startStream(ctx context.Context) (err error) {
+	s := d.stream
+
+	s.lock.Lock()
+	if s.active || s.unsupported || len(s.ranges) == 0 {
+		s.lock.Unlock()
+		return
+	}
+	ranges := append([]*streamRange(nil), s.ranges...)
+	s.lock.Unlock()
+
+	sb := make([]byte, 64)
+	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
+	sb[4] = byte(OpSTREAM)
+	sb[5] = byte(SpaceSNES)
+	sb[6] = byte(FlagDATA64B | FlagSTREAM_BURST)
+
+	total := 0
+	sp := sb[32:]
+	for _, r := range ranges {
+		copy(sp, []byte{
+			byte(len(r.data)),
+			byte((r.addr >> 16) & 0xFF),
+			byte((r.addr >> 8) & 0xFF),
+			byte((r.addr >> 0) & 0xFF),
+		})
+		sp = sp[4:]
+		total += len(r.data)
+	}
+
+	err = sendSerial(s.port, sb)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	err = recvSerial(ctx, s.port, sb, 64)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
+		err = fmt.Errorf("stream: fxpakpro response packet does not contain USBA header")
+		err = d.ProtocolError(err)
+		return
+	}
+	if sb[4] != byte(OpRESPONSE) {
+		err = fmt.Errorf("stream: wrong opcode in response packet; got $%02x", sb[4])
+		err = d.ProtocolError(err)
+		return
+	}
+	if ec := sb[5]; ec != 0 {
+		// fall back to polling:
+		log.Printf("fxpakpro: stream: %v; falling back to polling\n", fxpakproError(ec))
+		s.lock.Lock()
+		s.unsupported = true
+		s.lock.Unlock()
+		return
+	}
+
+	// read the current contents of the watched ranges:
+	burst := make([]byte, (total+63)&^63)
+	err = recvSerial(ctx, s.port, burst, uint32(len(burst)))
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	s.lock.Lock()
+	for _, r := range ranges {
+		burst = burst[copy(r.data, burst):]
+	}
+	s.active = true
+	s.done = make(chan struct{})
+	go s.run(s.done)
+	s.lock.Unlock()
+
+	return
+}
+
+// stop stops an active stream and waits for its end frame. If the end frame does not arrive the port is closed and a
+// fatal error is returned.
+func (s *streamer) stop() error {
+	s.lock.Lock()
+	if !s.active {
+		s.lock.Unlock()
+		return nil
+	}
+	done := s.done
+	s.lock.Unlock()
+
+	sb := make([]byte, 64)
+	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
+	sb[4] = byte(OpSTREAM)
+	sb[5] = byte(SpaceSNES)
+	sb[6] = byte(FlagDATA64B | FlagNORESP)
+	if err := sendSerial(s.port, sb); err != nil {
+		return err
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(streamStopTimeout):
+	}
+
+	// the stream goroutine would consume the replies to the next command so interrupt its read by closing the port:
+	_ = s.port.Close()
+	<-done
+	return devices.DeviceFatalKind(devices.ErrorKindTimeout, "fxpakpro: stream: timed out waiting for end frame", nil)
+}
+
+// run applies stream frames to the watched ranges until the end frame or an error
+func (s *streamer) run(done chan struct{}) {
+	defer close(done)
+	defer func() {
+		s.lock.Lock()
+		s.active = false
+		s.lock.Unlock()
+	}()
+
+	frame := make([]byte, streamFrameSize)
+	for {
+		if err := s.readFrame(frame); err != nil {
+			log.Printf("fxpakpro: stream: %v\n", err)
+			return
+		}
+		if frame[0] != 'U' || frame[1] != 'S' || frame[2] != 'B' || frame[3] != 'S' {
+			log.Printf("fxpakpro: stream: frame does not contain USBS header\n")
+			return
+		}
+
+		switch streamFrameType(frame[4]) {
+		case streamFrameEnd:
+			return
+		case streamFrameWrites:
+			s.apply(frame)
+		}
+	}
+}
+
+// readFrame waits indefinitely for a whole frame since there are no frames while watched memory does not change
+func (s *streamer) readFrame(frame []byte) (err error) {
+	if err = s.port.SetReadTimeout(serial.NoTimeout); err != nil {
+		return
+	}
+	for p := 0; p < len(frame); {
+		var n int
+		n, err = s.port.Read(frame[p:])
+		if err != nil {
+			return
+		}
+		p += n
+	}
+	return
+}
+
+func (s *streamer) apply(frame []byte) {
+	count := int(frame[5])
+	if count > streamRecordsPerFrame {
+		count = streamRecordsPerFrame
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i := 0; i < count; i++ {
+		rec := frame[streamRecordsOffset+i*4:]
+		addr := uint32(rec[0])<<16 | uint32(rec[1])<<8 | uint32(rec[2])
+		for _, r := range s.ranges {
+			if r.contains(addr) {
+				r.data[addr-r.addr] = rec[3]
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"go.bug.st/serial"
+	"sni/devices"
+	"sni/protos/sni"
+	"testing"
+	"time"
+)
+
+func openStreamingDevice(tb testing.TB) (*Device, *Simulator) {
+	d, sim := openSimulatedDevice(tb)
+	d.enableStream()
+	return d, sim
+}
+
+func readWRAM(t *testing.T, d *Device, address uint32, size int) []byte {
+	t.Helper()
+	rsp, err := d.MultiReadMemory(context.Background(), devices.MemoryReadRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:       address,
+			AddressSpace:  sni.AddressSpace_SnesABus,
+			MemoryMapping: sni.MemoryMapping_LoROM,
+		},
+		Size: size,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rsp[0].Data
+}
+
+func awaitWRAM(t *testing.T, d *Device, address uint32, expected []byte) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second * 2)
+	for {
+		actual := readWRAM(t, d, address, len(expected))
+		if bytes.Equal(actual, expected) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("WRAM at $%06x = %v, want %v", address, actual, expected)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDevice_stream(t *testing.T) {
+	d, sim := openStreamingDevice(t)
+	defer d.Close()
+
+	sim.Memory[0xF50010] = 0x11
+	sim.Memory[0xF50200] = 0x22
+
+	// the first read polls and starts the stream; 0x300 bytes needs several ranges:
+	if data := readWRAM(t, d, 0x7E0000, 0x300); data[0x10] != 0x11 || data[0x200] != 0x22 {
+		t.Fatalf("read $%02x $%02x", data[0x10], data[0x200])
+	}
+	d.stream.lock.Lock()
+	active := d.stream.active
+	d.stream.lock.Unlock()
+	if !active {
+		t.Fatal("expected stream to be active")
+	}
+
+	// watched reads must not need the device lock:
+	d.lock.Lock()
+	sim.Write(0xF50010, []byte{0x33})
+	awaitWRAM(t, d, 0x7E0010, []byte{0x33})
+	d.lock.Unlock()
+
+	// writes crossing a range boundary and spanning several frames:
+	changes := make([]byte, 40)
+	for i := range changes {
+		changes[i] = byte(0x80 + i)
+	}
+	sim.Write(0xF500F0, changes)
+	awaitWRAM(t, d, 0x7E00F0, changes)
+
+	// any other command stops the stream:
+	if _, err := d.PutFile(context.Background(), "test", 1, bytes.NewReader([]byte{1}), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// changes made while the stream is stopped are picked up when it restarts:
+	sim.Memory[0xF50010] = 0x44
+	awaitWRAM(t, d, 0x7E0010, []byte{0x44})
+	sim.Write(0xF50011, []byte{0x55})
+	awaitWRAM(t, d, 0x7E0010, []byte{0x44, 0x55})
+}
+
+func TestDevice_streamUnwatchable(t *testing.T) {
+	d, _ := openStreamingDevice(t)
+	defer d.Close()
+
+	// ROM reads are never watched:
+	_, err := d.MultiReadMemory(context.Background(), devices.MemoryReadRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:       0x008000,
+			AddressSpace:  sni.AddressSpace_SnesABus,
+			MemoryMapping: sni.MemoryMapping_LoROM,
+		},
+		Size: 16,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.stream.lock.Lock()
+	defer d.stream.lock.Unlock()
+	if d.stream.active || len(d.stream.ranges) != 0 {
+		t.Fatal("expected no stream for ROM reads")
+	}
+}
+
+// unstoppablePort drops requests to stop streaming so that the device never sends the end frame
+type unstoppablePort struct {
+	serial.Port
+}
+
+func (p *unstoppablePort) Write(b []byte) (int, error) {
+	if len(b) >= 64 && b[4] == byte(OpSTREAM) && b[32] == 0 {
+		return len(b), nil
+	}
+	return p.Port.Write(b)
+}
+
+func TestDevice_streamStopTimeout(t *testing.T) {
+	sim := NewSimulator()
+	d := &Device{f: &unstoppablePort{Port: sim.Open()}}
+	if err := d.Init(); err != nil {
+		t.Fatal(err)
+	}
+	d.enableStream()
+	defer d.Close()
+
+	readWRAM(t, d, 0x7E0000, 0x10)
+	d.stream.lock.Lock()
+	done := d.stream.done
+	d.stream.lock.Unlock()
+
+	// a ROM read must stop the stream first:
+	_, err := d.MultiReadMemory(context.Background(), devices.MemoryReadRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:       0x008000,
+			AddressSpace:  sni.AddressSpace_SnesABus,
+			MemoryMapping: sni.MemoryMapping_LoROM,
+		},
+		Size: 16,
+	})
+	if !devices.IsFatal(err) {
+		t.Fatalf("expected fatal error; got %v", err)
+	}
+	if actual, expected := devices.KindOf(err), devices.ErrorKindTimeout; actual != expected {
+		t.Fatalf("error kind %s, want %s", actual, expected)
+	}
+
+	// the stream goroutine must not be left reading from the port:
+	select {
+	case <-done:
+	default:
+		t.Fatal("expected stream to have ended")
+	}
+}
@@ -60,6 +60,31 @@ const (
 	FeatDMA1
 )
 
+// cartridgeFeatures are enabled by the FPGA configuration for the loaded ROM; the rest are firmware features
+const cartridgeFeatures = FeatDSPX | FeatST0010 | FeatSRTC | FeatMSU1 | Feat213F
+
+var infoFlagNames = [...]string{
+	"DSPX",
+	"ST0010",
+	"SRTC",
+	"MSU1",
+	"213F",
+	"CMD_UNLOCK",
+	"USB1",
+	"DMA1",
+}
+
+// Names returns the names of the flags that are set, in bit order
+func (f info_flags) Names() (names []string) {
+	names = make([]string, 0, len(infoFlagNames))
+	for i, name := range infoFlagNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return
+}
+
 type file_type uint8
 
 const (
@@ -31,6 +31,13 @@ func (d *Device) PauseUnpause(ctx context.Context, pausedState bool) (paused boo
 	} else {
 		_, err = d.WriteDeadline([]byte("Unpause\n"), deadline)
 	}
+	if err != nil {
+		return
+	}
+
+	d.stateLock.Lock()
+	d.pauseKnown, d.paused = true, paused
+	d.stateLock.Unlock()
 	return
 }
 
@@ -41,5 +48,27 @@ func (d *Device) PauseToggle(ctx context.Context) (err error) {
 	}
 
 	_, err = d.WriteDeadline([]byte("PauseToggle\n"), deadline)
+	if err != nil {
+		return
+	}
+
+	d.stateLock.Lock()
+	d.paused = !d.paused
+	d.stateLock.Unlock()
 	return
 }
+
+// status reports "paused" or "running" as of the last pause command sent or "" if none was sent yet, since the user
+// may have paused emulation in the emulator itself
+func (d *Device) status() string {
+	d.stateLock.Lock()
+	defer d.stateLock.Unlock()
+
+	if !d.pauseKnown {
+		return ""
+	}
+	if d.paused {
+		return "paused"
+	}
+	return "running"
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net"
 	"sni/devices"
+	"sni/devices/snes/romhash"
 	"sni/protos/sni"
 	"sni/util"
 	"strings"
@@ -31,16 +32,32 @@ type Device struct {
 	host       string
 	isBizHawk  bool
 	logPrefix  string
+
+	// the connector does not report whether emulation is paused so track the pause commands sent instead:
+	pauseKnown bool
+	paused     bool
+
+	romHash romhash.Cache
 }
 
 func (d *Device) FatalError(cause error) devices.DeviceError {
-	return devices.DeviceFatal(fmt.Sprintf("%v", cause), cause)
+	kind := devices.KindOf(cause)
+	if kind == devices.ErrorKindUnknown {
+		// fatal errors come from the socket so treat them as a lost connection:
+		kind = devices.ErrorKindDisconnected
+	}
+	return devices.DeviceFatalKind(kind, fmt.Sprintf("%v", cause), cause)
 }
 
 func (d *Device) NonFatalError(cause error) devices.DeviceError {
 	return devices.DeviceNonFatal(fmt.Sprintf("%v", cause), cause)
 }
 
+// ProtocolError is fatal since the line-based reply stream can no longer be trusted
+func (d *Device) ProtocolError(cause error) devices.DeviceError {
+	return devices.DeviceFatalKind(devices.ErrorKindProtocolViolation, fmt.Sprintf("%v", cause), cause)
+}
+
 func NewDevice(conn *net.TCPConn, key string) *Device {
 	d := &Device{
 		c:          conn,
@@ -118,12 +135,12 @@ func (d *Device) CheckVersion() (err error) {
 	rspn := strings.Split(rsp, "|")
 	if len(rspn) < 3 {
 		err = fmt.Errorf("expected Version response")
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 	if rspn[0] != "Version" {
 		err = fmt.Errorf("expected Version response")
-		err = d.FatalError(err)
+		err = d.ProtocolError(err)
 		return
 	}
 
@@ -153,6 +170,26 @@ func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 		case sni.Field_DeviceVersion:
 			values = append(values, d.version)
 			break
+		case sni.Field_DeviceStatus:
+			values = append(values, d.status())
+			break
+		case sni.Field_RomHashType:
+			values = append(values, romhash.Type())
+			break
+		case sni.Field_RomHashValue:
+			// the connector does not report the ROM file name so the ROM header identifies the cached hash:
+			var romKey, hashValue string
+			if romKey, err = romhash.HeaderKey(ctx, d); err != nil {
+				return
+			}
+			_, hashValue, err = d.romHash.Get(ctx, romKey, func(ctx context.Context) ([]byte, error) {
+				return romhash.ReadROM(ctx, d)
+			})
+			if err != nil {
+				return
+			}
+			values = append(values, hashValue)
+			break
 		default:
 			// unknown value; append empty string to maintain index association:
 			values = append(values, "")
@@ -54,6 +54,11 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_PauseUnpauseEmulation,
 	sni.DeviceCapability_PauseToggleEmulation,
 	sni.DeviceCapability_FetchFields,
+	sni.DeviceCapability_SaveState,
+	sni.DeviceCapability_LoadState,
+	sni.DeviceCapability_SaveStateSlot,
+	sni.DeviceCapability_LoadStateSlot,
+	sni.DeviceCapability_Screenshot,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
@@ -0,0 +1,90 @@
+package luabridge
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"strconv"
+	"time"
+)
+
+// connectorVersionEmulator is the first Connector.lua version with the SaveState, LoadState and Screenshot commands
+const connectorVersionEmulator = 5
+
+// save state slots accepted by snes9x-rr's savestate.create and BizHawk's savestate.saveslot/loadslot:
+const (
+	snes9xMinStateSlot  = 1
+	snes9xMaxStateSlot  = 12
+	bizHawkMinStateSlot = 0
+	bizHawkMaxStateSlot = 9
+)
+
+// requireConnector fails with codes.Unimplemented if the connected script does not support command
+func (d *Device) requireConnector(command string, bizHawkOnly bool) error {
+	d.stateLock.Lock()
+	defer d.stateLock.Unlock()
+
+	version, _ := strconv.Atoi(d.version)
+	if d.clientName != "SNI Connector" || version < connectorVersionEmulator {
+		return devices.WithCode(codes.Unimplemented, fmt.Errorf(
+			"luabridge: %s requires Connector.lua v%d or newer; update the lua script in the emulator",
+			command,
+			connectorVersionEmulator,
+		))
+	}
+	if bizHawkOnly && !d.isBizHawk {
+		return devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: %s is only supported by BizHawk", command))
+	}
+	return nil
+}
+
+func (d *Device) stateCommand(ctx context.Context, command string, slot int) (err error) {
+	if slot < 0 {
+		return devices.WithCode(codes.InvalidArgument, fmt.Errorf("luabridge: a state slot is required"))
+	}
+	if err = d.requireConnector(command, false); err != nil {
+		return
+	}
+
+	// the connector script stops if the emulator rejects the slot:
+	minSlot, maxSlot := snes9xMinStateSlot, snes9xMaxStateSlot
+	d.stateLock.Lock()
+	if d.isBizHawk {
+		minSlot, maxSlot = bizHawkMinStateSlot, bizHawkMaxStateSlot
+	}
+	d.stateLock.Unlock()
+	if slot < minSlot || slot > maxSlot {
+		return devices.WithCode(codes.InvalidArgument, fmt.Errorf("luabridge: state slot %d out of range %d..%d", slot, minSlot, maxSlot))
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(readWriteTimeout)
+	}
+
+	_, err = d.WriteDeadline([]byte(fmt.Sprintf("%s|%d\n", command, slot)), deadline)
+	return
+}
+
+func (d *Device) SaveState(ctx context.Context, slot int) error {
+	return d.stateCommand(ctx, "SaveState", slot)
+}
+
+func (d *Device) LoadState(ctx context.Context, slot int) error {
+	return d.stateCommand(ctx, "LoadState", slot)
+}
+
+func (d *Device) Screenshot(ctx context.Context) (err error) {
+	if err = d.requireConnector("Screenshot", true); err != nil {
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(readWriteTimeout)
+	}
+
+	_, err = d.WriteDeadline([]byte("Screenshot\n"), deadline)
+	return
+}
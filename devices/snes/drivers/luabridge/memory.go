@@ -91,7 +91,15 @@ func (d *Device) MultiReadMemory(ctx context.Context, reads ...devices.MemoryRea
 			var offset uint32
 			addressSpace = sni.AddressSpace_FxPakPro
 			domain, addr, offset = mapping.MemoryTypeFor(read.RequestAddress)
-			if domain == "SRAM" {
+			if !domain.HasEmulatorDomain() {
+				err = devices.DeviceNonFatalKind(
+					devices.ErrorKindUnsupportedDomain,
+					fmt.Sprintf("luabridge: memory type %s is not accessible on BizHawk", domain),
+					nil,
+				)
+				return
+			}
+			if domain == mapping.MemoryTypeSRAM {
 				domain = "CARTRAM"
 			}
 			_, _ = fmt.Fprintf(sb, "Read|%d|%d|%s\n", offset, read.Size, domain)
@@ -149,13 +157,13 @@ func (d *Device) MultiReadMemory(ctx context.Context, reads ...devices.MemoryRea
 			data, err = d.parseJsonResponse(rspstr)
 		}
 		if err != nil {
-			err = d.FatalError(err)
+			err = d.ProtocolError(err)
 			return
 		}
 
 		if actual, expected := len(data), read.Size; actual != expected {
 			err = fmt.Errorf("response did not provide enough data to meet request size; actual $%x, expected $%x", actual, expected)
-			err = d.FatalError(err)
+			err = d.ProtocolError(err)
 			return
 		}
 
@@ -251,7 +259,15 @@ func (d *Device) MultiWriteMemory(ctx context.Context, writes ...devices.MemoryW
 			var offset uint32
 			addressSpace = sni.AddressSpace_FxPakPro
 			domain, addr, offset = mapping.MemoryTypeFor(write.RequestAddress)
-			if domain == "SRAM" {
+			if !domain.HasEmulatorDomain() {
+				err = devices.DeviceNonFatalKind(
+					devices.ErrorKindUnsupportedDomain,
+					fmt.Sprintf("luabridge: memory type %s is not accessible on BizHawk", domain),
+					nil,
+				)
+				return
+			}
+			if domain == mapping.MemoryTypeSRAM {
 				domain = "CARTRAM"
 			}
 			_, _ = fmt.Fprintf(sb, "Write|%d|%s", offset, domain)
@@ -293,3 +309,8 @@ func (d *Device) MultiWriteMemory(ctx context.Context, writes ...devices.MemoryW
 
 	return
 }
+
+func (d *Device) CompareAndWriteMemory(ctx context.Context, request devices.MemoryCompareAndWriteRequest) (devices.MemoryCompareAndWriteResponse, error) {
+	// emulator does not support atomic compare-and-write so fall back to read-compare-write while paused:
+	return devices.CompareAndWriteWhilePaused(ctx, d, request)
+}
@@ -0,0 +1,47 @@
+package mock
+
+import (
+	"context"
+)
+
+// reset clears WRAM and unpauses as a console reset would; must be called with d.lock held
+func (d *Device) reset() {
+	for i := range d.WRAM {
+		d.WRAM[i] = 0
+	}
+	d.paused = false
+}
+
+func (d *Device) ResetSystem(ctx context.Context) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.reset()
+	return nil
+}
+
+func (d *Device) ResetToMenu(ctx context.Context) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	// there is no menu ROM so just unload the current ROM:
+	d.unloadROM()
+	d.reset()
+	return nil
+}
+
+func (d *Device) PauseUnpause(ctx context.Context, pausedState bool) (bool, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.paused = pausedState
+	return d.paused, nil
+}
+
+func (d *Device) PauseToggle(ctx context.Context) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.paused = !d.paused
+	return nil
+}
@@ -1,15 +1,22 @@
 package mock
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"github.com/alttpo/snes/timing"
+	"os"
 	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/devices/snes/romhash"
 	"sni/protos/sni"
 	"sni/util"
 	"sync"
 	"time"
 )
 
+// Device is a virtual console whose Memory is laid out in the FX Pak Pro address space: the ROM file is stored
+// linearly from $000000, SRAM from $E00000 and WRAM from $F50000.
 type Device struct {
 	lock sync.Mutex
 
@@ -17,9 +24,43 @@ type Device struct {
 
 	WRAM   []byte
 	Memory [0x1000000]byte
+
+	isClosed bool
+	paused   bool
+
+	// the loaded ROM; romMapping is used to translate SNES A-bus addresses with an Unknown mapping:
+	romName    string
+	romSize    int
+	romHash    romhash.Cache
+	romMapping sni.MemoryMapping
+
+	// sdRoot is the host directory backing the virtual SD card:
+	sdRoot string
+}
+
+func (d *Device) FatalError(cause error) devices.DeviceError {
+	return devices.DeviceFatal(fmt.Sprintf("mock: %v", cause), cause)
+}
+
+func (d *Device) NonFatalError(cause error) devices.DeviceError {
+	return devices.DeviceNonFatal(fmt.Sprintf("mock: %v", cause), cause)
+}
+
+// checkRange fails with ErrorKindAddressOutOfRange if the given range does not fit in Memory
+func (d *Device) checkRange(address uint32, size int) error {
+	if size < 0 || int(address)+size > len(d.Memory) {
+		return devices.DeviceNonFatalKind(
+			devices.ErrorKindAddressOutOfRange,
+			fmt.Sprintf("mock: address range $%06x size $%x is out of range", address, size),
+			nil,
+		)
+	}
+	return nil
 }
 
 func (d *Device) Init() {
+	d.WRAM = d.Memory[0xF50000:0xF70000]
+
 	// 5,369,317.5/89,341.5 ~= 60.0988 frames / sec ~= 16,639,265.605 ns / frame
 	d.frameTicker = time.NewTicker(timing.Frame)
 
@@ -27,25 +68,46 @@ func (d *Device) Init() {
 		defer util.Recover()
 
 		for range d.frameTicker.C {
-			// increment frame timer:
-			d.WRAM[0x1A]++
+			d.lock.Lock()
+			if !d.paused {
+				// increment frame timer:
+				d.WRAM[0x1A]++
+			}
+			d.lock.Unlock()
 		}
 	}()
 }
 
 func (d *Device) IsClosed() bool {
-	return false
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.isClosed
 }
 
-func (d *Device) Close() error {
-	panic("implement me")
+func (d *Device) Close() (err error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.isClosed {
+		return
+	}
+	d.isClosed = true
+
+	if d.frameTicker != nil {
+		d.frameTicker.Stop()
+	}
+	if d.sdRoot != "" {
+		err = os.RemoveAll(d.sdRoot)
+	}
+	return
 }
 
 func (d *Device) RequiresMemoryMappingForAddressSpace(ctx context.Context, addressSpace sni.AddressSpace) (bool, error) {
 	if addressSpace == sni.AddressSpace_Raw {
 		return false, nil
 	}
-	if addressSpace == sni.AddressSpace_SnesABus {
+	if addressSpace == sni.AddressSpace_FxPakPro {
 		return false, nil
 	}
 	return true, nil
@@ -55,12 +117,33 @@ func (d *Device) RequiresMemoryMappingForAddress(ctx context.Context, address de
 	if address.AddressSpace == sni.AddressSpace_Raw {
 		return false, nil
 	}
-	if address.AddressSpace == sni.AddressSpace_SnesABus {
+	if address.AddressSpace == sni.AddressSpace_FxPakPro {
 		return false, nil
 	}
 	return true, nil
 }
 
+// translate converts the request address to an FX Pak Pro address; must be called with d.lock held
+func (d *Device) translate(address devices.AddressTuple) (deviceAddress devices.AddressTuple, err error) {
+	if address.MemoryMapping == sni.MemoryMapping_Unknown {
+		address.MemoryMapping = d.romMapping
+	}
+	if address.MemoryMapping == sni.MemoryMapping_Unknown {
+		// no ROM is loaded so assume LoROM to at least allow WRAM access:
+		address.MemoryMapping = sni.MemoryMapping_LoROM
+	}
+
+	deviceAddress = devices.AddressTuple{
+		AddressSpace:  sni.AddressSpace_FxPakPro,
+		MemoryMapping: address.MemoryMapping,
+	}
+	deviceAddress.Address, err = mapping.TranslateAddress(address, sni.AddressSpace_FxPakPro)
+	if err != nil {
+		err = d.NonFatalError(err)
+	}
+	return
+}
+
 func (d *Device) MultiReadMemory(context context.Context, reads ...devices.MemoryReadRequest) (mrsps []devices.MemoryReadResponse, err error) {
 	// wait 1ms before returning response to simulate the delay of FX Pak Pro device:
 	<-time.After(time.Millisecond * 1)
@@ -69,12 +152,25 @@ func (d *Device) MultiReadMemory(context context.Context, reads ...devices.Memor
 		return
 	}
 
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
 	mrsps = make([]devices.MemoryReadResponse, 0, len(reads))
 	for _, read := range reads {
+		var deviceAddress devices.AddressTuple
+		deviceAddress, err = d.translate(read.RequestAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.checkRange(deviceAddress.Address, read.Size); err != nil {
+			return nil, err
+		}
+
 		data := make([]byte, read.Size)
-		copy(data, d.Memory[read.RequestAddress.Address:int(read.RequestAddress.Address)+read.Size])
+		copy(data, d.Memory[deviceAddress.Address:int(deviceAddress.Address)+read.Size])
 		mrsps = append(mrsps, devices.MemoryReadResponse{
 			RequestAddress: read.RequestAddress,
+			DeviceAddress:  deviceAddress,
 			Data:           data,
 		})
 	}
@@ -90,15 +186,28 @@ func (d *Device) MultiWriteMemory(context context.Context, writes ...devices.Mem
 		return
 	}
 
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
 	mrsps = make([]devices.MemoryWriteResponse, 0, len(writes))
 	for _, write := range writes {
 		data := write.Data
 		dataLen := len(data)
 
-		copy(d.Memory[write.RequestAddress.Address:int(write.RequestAddress.Address)+dataLen], data)
+		var deviceAddress devices.AddressTuple
+		deviceAddress, err = d.translate(write.RequestAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.checkRange(deviceAddress.Address, dataLen); err != nil {
+			return nil, err
+		}
+
+		copy(d.Memory[deviceAddress.Address:int(deviceAddress.Address)+dataLen], data)
 
 		mrsps = append(mrsps, devices.MemoryWriteResponse{
 			RequestAddress: write.RequestAddress,
+			DeviceAddress:  deviceAddress,
 			Size:           dataLen,
 		})
 	}
@@ -106,18 +215,29 @@ func (d *Device) MultiWriteMemory(context context.Context, writes ...devices.Mem
 	return
 }
 
-func (d *Device) ResetSystem(ctx context.Context) error {
-	panic("implement me")
-}
+func (d *Device) CompareAndWriteMemory(ctx context.Context, request devices.MemoryCompareAndWriteRequest) (rsp devices.MemoryCompareAndWriteResponse, err error) {
+	if len(request.Compare) != len(request.Data) {
+		err = d.NonFatalError(fmt.Errorf("compare length %d must equal data length %d", len(request.Compare), len(request.Data)))
+		return
+	}
 
-func (d *Device) ResetToMenu(ctx context.Context) error {
-	panic("implement me")
-}
+	d.lock.Lock()
+	defer d.lock.Unlock()
 
-func (d *Device) PauseUnpause(ctx context.Context, pausedState bool) (bool, error) {
-	panic("implement me")
-}
+	rsp.RequestAddress = request.RequestAddress
+	rsp.DeviceAddress, err = d.translate(request.RequestAddress)
+	if err != nil {
+		return
+	}
+	if err = d.checkRange(rsp.DeviceAddress.Address, len(request.Data)); err != nil {
+		return
+	}
 
-func (d *Device) PauseToggle(ctx context.Context) error {
-	panic("implement me")
+	start := int(rsp.DeviceAddress.Address)
+	if bytes.Equal(d.Memory[start:start+len(request.Compare)], request.Compare) {
+		copy(d.Memory[start:start+len(request.Data)], request.Data)
+		rsp.Written = true
+	}
+
+	return
 }
@@ -0,0 +1,224 @@
+package mock
+
+import (
+	"bytes"
+	"context"
+	"sni/devices"
+	"sni/protos/sni"
+	"testing"
+)
+
+func newTestDevice(t *testing.T) *Device {
+	t.Helper()
+
+	d := &Device{sdRoot: t.TempDir()}
+	d.Init()
+	t.Cleanup(func() { _ = d.Close() })
+	return d
+}
+
+// makeROM creates a ROM image with a plausible header at headerOffset ($7FB0 for LoROM, $FFB0 for HiROM, $40FFB0 for
+// ExHiROM):
+func makeROM(size int, headerOffset int, mapMode byte) []byte {
+	rom := make([]byte, size)
+	for i := range rom {
+		rom[i] = byte(i >> 8)
+	}
+
+	h := rom[headerOffset : headerOffset+0x50]
+	for i := 0x10; i < 0x50; i++ {
+		h[i] = 0
+	}
+	copy(h[0x10:0x25], "MOCK TEST ROM        ")
+	h[0x25] = mapMode
+	// all vectors point into ROM:
+	for i := 0x30; i < 0x50; i += 2 {
+		h[i], h[i+1] = 0x00, 0x80
+	}
+	return rom
+}
+
+func TestDevice_LoadROM(t *testing.T) {
+	tests := []struct {
+		name         string
+		romSize      int
+		headerOffset int
+		mapMode      byte
+		wantMapping  sni.MemoryMapping
+		busAddress   uint32
+		pakAddress   uint32
+	}{
+		{
+			name:         "LoROM",
+			headerOffset: 0x7FB0,
+			mapMode:      0x20,
+			wantMapping:  sni.MemoryMapping_LoROM,
+			busAddress:   0x018000,
+			pakAddress:   0x008000,
+		},
+		{
+			name:         "HiROM",
+			headerOffset: 0xFFB0,
+			mapMode:      0x21,
+			wantMapping:  sni.MemoryMapping_HiROM,
+			busAddress:   0xC12345,
+			pakAddress:   0x012345,
+		},
+		{
+			name:         "SA-1",
+			headerOffset: 0x7FB0,
+			mapMode:      0x23,
+			wantMapping:  sni.MemoryMapping_SA1,
+			busAddress:   0xC12345,
+			pakAddress:   0x012345,
+		},
+		{
+			name:         "ExHiROM",
+			romSize:      0x420000,
+			headerOffset: 0x40FFB0,
+			mapMode:      0x25,
+			wantMapping:  sni.MemoryMapping_ExHiROM,
+			busAddress:   0x412345,
+			pakAddress:   0x412345,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestDevice(t)
+			ctx := context.Background()
+
+			romSize := tt.romSize
+			if romSize == 0 {
+				romSize = 0x20000
+			}
+			rom := makeROM(romSize, tt.headerOffset, tt.mapMode)
+			if err := d.LoadROM(ctx, "test.sfc", rom); err != nil {
+				t.Fatal(err)
+			}
+			if d.romMapping != tt.wantMapping {
+				t.Fatalf("detected mapping %s, want %s", d.romMapping, tt.wantMapping)
+			}
+
+			rsps, err := d.MultiReadMemory(ctx, devices.MemoryReadRequest{
+				RequestAddress: devices.AddressTuple{
+					Address:       tt.busAddress,
+					AddressSpace:  sni.AddressSpace_SnesABus,
+					MemoryMapping: sni.MemoryMapping_Unknown,
+				},
+				Size: 16,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual, expected := rsps[0].DeviceAddress.Address, tt.pakAddress; actual != expected {
+				t.Errorf("device address $%06x, want $%06x", actual, expected)
+			}
+			if actual, expected := rsps[0].Data, rom[tt.pakAddress:tt.pakAddress+16]; !bytes.Equal(actual, expected) {
+				t.Errorf("data %#v, want %#v", actual, expected)
+			}
+
+			values, err := d.FetchFields(ctx, sni.Field_RomFileName, sni.Field_DeviceStatus)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if values[0] != "test.sfc" || values[1] != "PLAYING" {
+				t.Errorf("fields %v, want [test.sfc PLAYING]", values)
+			}
+		})
+	}
+}
+
+func TestDevice_OutOfRange(t *testing.T) {
+	d := newTestDevice(t)
+
+	_, err := d.MultiReadMemory(context.Background(), devices.MemoryReadRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:      0xFFFFF0,
+			AddressSpace: sni.AddressSpace_FxPakPro,
+		},
+		Size: 0x20,
+	})
+	if actual, expected := devices.KindOf(err), devices.ErrorKindAddressOutOfRange; actual != expected {
+		t.Fatalf("error kind %s, want %s", actual, expected)
+	}
+}
+
+func TestDevice_Filesystem(t *testing.T) {
+	d := newTestDevice(t)
+	ctx := context.Background()
+
+	if err := d.MakeDirectory(ctx, "/roms"); err != nil {
+		t.Fatal(err)
+	}
+
+	rom := makeROM(0x10000, 0x7FB0, 0x20)
+	n, err := d.PutFile(ctx, "/roms/game.sfc", uint32(len(rom)), bytes.NewReader(rom), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != uint32(len(rom)) {
+		t.Fatalf("PutFile wrote %d, want %d", n, len(rom))
+	}
+
+	entries, err := d.ReadDirectory(ctx, "/roms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name != "game.sfc" || entries[0].Type != sni.DirEntryType_File {
+		t.Fatalf("ReadDirectory = %+v", entries)
+	}
+
+	// paths must not escape the virtual SD card:
+	if _, err = d.ReadDirectory(ctx, "/../.."); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = d.BootFile(ctx, "/roms/game.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	values, err := d.FetchFields(ctx, sni.Field_RomFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0] != "/roms/game.sfc" {
+		t.Fatalf("RomFileName = %q", values[0])
+	}
+
+	var b bytes.Buffer
+	if _, err = d.GetFile(ctx, "/roms/game.sfc", &b, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b.Bytes(), rom) {
+		t.Fatal("GetFile contents differ from PutFile contents")
+	}
+
+	if err = d.RenameFile(ctx, "/roms/game.sfc", "other.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.RemoveFile(ctx, "/roms/other.sfc"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDevice_PauseAndReset(t *testing.T) {
+	d := newTestDevice(t)
+	ctx := context.Background()
+
+	if paused, err := d.PauseUnpause(ctx, true); err != nil || !paused {
+		t.Fatalf("PauseUnpause = %v, %v", paused, err)
+	}
+	if err := d.PauseToggle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if d.paused {
+		t.Fatal("expected unpaused after toggle")
+	}
+
+	_ = d.PauseToggle(ctx)
+	if err := d.ResetSystem(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if d.paused {
+		t.Fatal("expected unpaused after reset")
+	}
+}
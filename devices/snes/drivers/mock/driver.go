@@ -1,8 +1,10 @@
 package mock
 
 import (
+	"context"
 	"log"
 	"net/url"
+	"os"
 	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/protos/sni"
@@ -33,6 +35,19 @@ func (d *Driver) Kind() string { return "mock" }
 var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_ReadMemory,
 	sni.DeviceCapability_WriteMemory,
+	sni.DeviceCapability_ResetSystem,
+	sni.DeviceCapability_ResetToMenu,
+	sni.DeviceCapability_PauseUnpauseEmulation,
+	sni.DeviceCapability_PauseToggleEmulation,
+	sni.DeviceCapability_FetchFields,
+	// filesystem:
+	sni.DeviceCapability_ReadDirectory,
+	sni.DeviceCapability_MakeDirectory,
+	sni.DeviceCapability_RemoveFile,
+	sni.DeviceCapability_RenameFile,
+	sni.DeviceCapability_PutFile,
+	sni.DeviceCapability_GetFile,
+	sni.DeviceCapability_BootFile,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
@@ -52,16 +67,24 @@ func (d *Driver) Detect() ([]devices.DeviceDescriptor, error) {
 	}, nil
 }
 
+// openDevice is called by the container with its lock held so it must not look up devices in the container
 func (d *Driver) openDevice(uri *url.URL) (devices.Device, error) {
-	dev, ok := d.container.GetDevice(d.DeviceKey(uri))
-	if ok {
-		return dev, nil
+	// back the virtual SD card with a temporary directory which is removed on Close:
+	sdRoot, err := os.MkdirTemp("", "sni-mock-sd-")
+	if err != nil {
+		return nil, err
 	}
 
-	mock := &Device{}
-	mock.WRAM = mock.Memory[0xF50000:0xF70000]
+	mock := &Device{sdRoot: sdRoot}
 	mock.Init()
 
+	// optionally load a ROM from the host filesystem:
+	if romPath := config.Config.GetString("mock_rom"); romPath != "" {
+		if err = mock.LoadROMFile(context.Background(), romPath); err != nil {
+			log.Printf("%s: could not load ROM '%s': %v\n", driverName, romPath, err)
+		}
+	}
+
 	return mock, nil
 }
 
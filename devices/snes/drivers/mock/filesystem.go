@@ -0,0 +1,163 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sni/devices"
+	"sni/protos/sni"
+	"strings"
+)
+
+// sdPath resolves a virtual SD card path to a host path that cannot escape sdRoot
+func (d *Device) sdPath(p string) string {
+	return filepath.Join(d.sdRoot, filepath.FromSlash(path.Clean("/"+p)))
+}
+
+func (d *Device) ReadDirectory(ctx context.Context, path string) (files []devices.DirEntry, err error) {
+	var entries []os.DirEntry
+	entries, err = os.ReadDir(d.sdPath(path))
+	if err != nil {
+		err = d.NonFatalError(err)
+		return
+	}
+
+	files = make([]devices.DirEntry, 0, len(entries))
+	for _, entry := range entries {
+		file := devices.DirEntry{
+			Name: entry.Name(),
+			Type: sni.DirEntryType_File,
+		}
+		if entry.IsDir() {
+			file.Type = sni.DirEntryType_Directory
+		}
+		files = append(files, file)
+	}
+	return
+}
+
+func (d *Device) MakeDirectory(ctx context.Context, path string) (err error) {
+	err = os.Mkdir(d.sdPath(path), 0755)
+	if err != nil {
+		err = d.NonFatalError(err)
+	}
+	return
+}
+
+func (d *Device) RemoveFile(ctx context.Context, path string) (err error) {
+	err = os.Remove(d.sdPath(path))
+	if err != nil {
+		err = d.NonFatalError(err)
+	}
+	return
+}
+
+func (d *Device) RenameFile(ctx context.Context, path, newFilename string) (err error) {
+	// like the FX Pak Pro, newFilename is a name within the same directory:
+	if strings.ContainsAny(newFilename, "/\\") {
+		return d.NonFatalError(fmt.Errorf("new filename '%s' must not contain path separators", newFilename))
+	}
+
+	oldPath := d.sdPath(path)
+	err = os.Rename(oldPath, filepath.Join(filepath.Dir(oldPath), newFilename))
+	if err != nil {
+		err = d.NonFatalError(err)
+	}
+	return
+}
+
+func (d *Device) PutFile(ctx context.Context, path string, size uint32, r io.Reader, progress devices.ProgressReportFunc) (n uint32, err error) {
+	var f *os.File
+	f, err = os.Create(d.sdPath(path))
+	if err != nil {
+		err = d.NonFatalError(err)
+		return
+	}
+	defer f.Close()
+
+	var buf [512]byte
+	for n < size {
+		var nr int
+		chunk := buf[:]
+		if remaining := size - n; remaining < uint32(len(chunk)) {
+			chunk = chunk[:remaining]
+		}
+		nr, err = io.ReadFull(r, chunk)
+		if err != nil {
+			err = d.NonFatalError(err)
+			return
+		}
+		if _, err = f.Write(chunk[:nr]); err != nil {
+			err = d.NonFatalError(err)
+			return
+		}
+		n += uint32(nr)
+
+		if progress != nil {
+			progress(n, size)
+		}
+	}
+
+	return
+}
+
+func (d *Device) GetFile(ctx context.Context, path string, w io.Writer, sizeReceived devices.SizeReceivedFunc, progress devices.ProgressReportFunc) (size uint32, err error) {
+	var f *os.File
+	f, err = os.Open(d.sdPath(path))
+	if err != nil {
+		err = d.NonFatalError(err)
+		return
+	}
+	defer f.Close()
+
+	var fi os.FileInfo
+	fi, err = f.Stat()
+	if err != nil {
+		err = d.NonFatalError(err)
+		return
+	}
+	size = uint32(fi.Size())
+	if sizeReceived != nil {
+		sizeReceived(size)
+	}
+
+	var buf [512]byte
+	received := uint32(0)
+	for received < size {
+		var nr int
+		nr, err = f.Read(buf[:])
+		if nr > 0 {
+			if _, werr := w.Write(buf[:nr]); werr != nil {
+				err = d.NonFatalError(werr)
+				return
+			}
+			received += uint32(nr)
+			if progress != nil {
+				progress(received, size)
+			}
+		}
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err != nil {
+			err = d.NonFatalError(err)
+			return
+		}
+	}
+
+	return
+}
+
+func (d *Device) BootFile(ctx context.Context, path string) (err error) {
+	var contents []byte
+	contents, err = os.ReadFile(d.sdPath(path))
+	if err != nil {
+		return d.NonFatalError(err)
+	}
+
+	return d.LoadROM(ctx, path, contents)
+}
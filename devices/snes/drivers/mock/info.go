@@ -0,0 +1,65 @@
+package mock
+
+import (
+	"context"
+	"sni/devices/snes/romhash"
+	"sni/protos/sni"
+)
+
+const deviceVersion = "1.0.0"
+
+func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error) {
+	d.lock.Lock()
+	// mirror the RetroArch GET_STATUS states:
+	status := "PLAYING"
+	if d.romSize == 0 {
+		status = "CONTENTLESS"
+	} else if d.paused {
+		status = "PAUSED"
+	}
+	romName := d.romName
+	// the ROM is hashed through MultiReadMemory which takes the lock:
+	d.lock.Unlock()
+
+	for _, field := range fields {
+		switch field {
+		case sni.Field_DeviceName:
+			values = append(values, "mock")
+			break
+		case sni.Field_DeviceVersion:
+			values = append(values, deviceVersion)
+			break
+		case sni.Field_DeviceStatus:
+			values = append(values, status)
+			break
+		case sni.Field_CoreName:
+			values = append(values, "mock")
+			break
+		case sni.Field_CorePlatform:
+			values = append(values, "snes")
+			break
+		case sni.Field_RomFileName:
+			values = append(values, romName)
+			break
+		case sni.Field_RomHashType:
+			values = append(values, romhash.Type())
+			break
+		case sni.Field_RomHashValue:
+			var hashValue string
+			_, hashValue, err = d.romHash.Get(ctx, romName, func(ctx context.Context) ([]byte, error) {
+				return romhash.ReadROM(ctx, d)
+			})
+			if err != nil {
+				return
+			}
+			values = append(values, hashValue)
+			break
+		default:
+			// unknown value; append empty string to maintain index association:
+			values = append(values, "")
+			break
+		}
+	}
+
+	return
+}
@@ -0,0 +1,86 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sni/devices/snes/mapping"
+	"sni/devices/snes/romhash"
+	"sni/protos/sni"
+)
+
+const (
+	// ROM occupies the FX Pak Pro address space up to SRAM:
+	romMaxSize = 0xE00000
+
+	pakSRAM  = 0xE00000
+	sizeSRAM = 0x100000
+)
+
+// LoadROMFile loads a ROM image from the host filesystem
+func (d *Device) LoadROMFile(ctx context.Context, path string) (err error) {
+	var contents []byte
+	contents, err = os.ReadFile(path)
+	if err != nil {
+		return d.NonFatalError(err)
+	}
+
+	return d.LoadROM(ctx, filepath.Base(path), contents)
+}
+
+// LoadROM places the ROM image linearly at $000000 in the FX Pak Pro address space, mirrored up to a power of two,
+// clears SRAM, resets the console and detects the memory mapping from the ROM header, leaving it unknown if the header
+// is not recognized.
+func (d *Device) LoadROM(ctx context.Context, name string, contents []byte) (err error) {
+	// strip the 512-byte copier header if present:
+	if len(contents)&0x3FF == 0x200 {
+		contents = contents[0x200:]
+	}
+	if actual, expected := len(contents), romMaxSize; actual > expected {
+		return d.NonFatalError(fmt.Errorf("ROM too large; $%x > $%x", actual, expected))
+	}
+
+	// a ROM whose size is not a power of two is mirrored to fill out the rest as on a cartridge:
+	image := romhash.Mirror(contents)
+	if len(image) > romMaxSize {
+		image = image[:romMaxSize]
+	}
+
+	d.lock.Lock()
+	d.unloadROM()
+	copy(d.Memory[:], image)
+	d.romName = name
+	d.romSize = len(image)
+	d.reset()
+	d.lock.Unlock()
+
+	// detect the mapping from the ROM header the same way clients do; the ROM stays loaded even if detection fails
+	// so clients may still specify their own mapping:
+	romMapping, _, _, derr := mapping.Detect(ctx, d, nil, nil)
+	if derr != nil {
+		log.Printf("%s: could not detect memory mapping of ROM '%s': %v\n", driverName, name, derr)
+		romMapping = sni.MemoryMapping_Unknown
+	}
+
+	d.lock.Lock()
+	d.romMapping = romMapping
+	d.lock.Unlock()
+	return
+}
+
+// unloadROM clears the ROM and SRAM regions; must be called with d.lock held
+func (d *Device) unloadROM() {
+	for i := 0; i < d.romSize; i++ {
+		d.Memory[i] = 0
+	}
+	for i := pakSRAM; i < pakSRAM+sizeSRAM; i++ {
+		d.Memory[i] = 0
+	}
+
+	d.romName = ""
+	d.romSize = 0
+	d.romHash.Reset()
+	d.romMapping = sni.MemoryMapping_Unknown
+}
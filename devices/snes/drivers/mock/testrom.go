@@ -0,0 +1,31 @@
+package mock
+
+import "strings"
+
+// LoROMImage returns a LoROM image of size bytes, a power of two from 32KiB, for tests. The image is filled with a
+// byte pattern and has a header naming the title, declaring the size and pointing every vector at $8000.
+func LoROMImage(title string, size int) []byte {
+	rom := make([]byte, size)
+	for i := range rom {
+		rom[i] = byte(i * 7)
+	}
+
+	h := rom[0x7FB0:0x8000]
+	for i := range h {
+		h[i] = 0
+	}
+	copy(h[0x10:0x25], title+strings.Repeat(" ", 21))
+	// LoROM:
+	h[0x25] = 0x20
+	// size declared as 1KiB << n:
+	for n := byte(0); 0x400<<n <= size; n++ {
+		h[0x27] = n
+	}
+	// country, developer; complement and checksum are not checked:
+	h[0x29], h[0x2A] = 0x01, 0x33
+	h[0x2C], h[0x2D] = 0xFF, 0xFF
+	for i := 0x30; i < 0x50; i += 2 {
+		h[i], h[i+1] = 0x00, 0x80
+	}
+	return rom
+}
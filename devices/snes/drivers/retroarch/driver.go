@@ -3,17 +3,19 @@ package retroarch
 import (
 	"fmt"
 	"log"
+	"math"
 	"net"
 	"net/url"
 	"runtime/debug"
 	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/protos/sni"
-	"strings"
 	"sync"
 	"time"
 
+	"github.com/alttpo/observable"
 	"github.com/alttpo/snes/timing"
+	"github.com/spf13/viper"
 )
 
 const driverName = "ra"
@@ -28,21 +30,69 @@ const defaultAddressSpace = sni.AddressSpace_SnesABus
 type Driver struct {
 	container devices.DeviceContainer
 
-	detectors []*RAClient
+	detectorsLock sync.Mutex
+	detectors     []*RAClient
 }
 
 func NewDriver(addresses []*net.UDPAddr) *Driver {
-	d := &Driver{
-		detectors: make([]*RAClient, len(addresses)),
-	}
+	d := &Driver{}
 	d.container = devices.NewDeviceDriverContainer(d.openDevice)
+	d.setAddresses(addresses)
+
+	return d
+}
 
-	for i, addr := range addresses {
-		c := NewRAClient(addr, fmt.Sprintf("retroarch[%d]", i), timing.Frame*4)
-		d.detectors[i] = c
+// setAddresses replaces the list of addresses to detect RetroArch instances on. Detectors of addresses already in
+// the list are kept; detectors and devices of addresses no longer in the list are closed.
+func (d *Driver) setAddresses(addresses []*net.UDPAddr) {
+	d.detectorsLock.Lock()
+	removed := make(map[string]*RAClient, len(d.detectors))
+	for _, c := range d.detectors {
+		removed[c.addr.String()] = c
 	}
 
-	return d
+	detectors := make([]*RAClient, 0, len(addresses))
+	for _, addr := range addresses {
+		key := addr.String()
+		if c, ok := removed[key]; ok {
+			delete(removed, key)
+			detectors = append(detectors, c)
+			continue
+		}
+
+		if d.detectors != nil {
+			log.Printf("retroarch: detecting on %s\n", key)
+		}
+		detectors = append(detectors, NewRAClient(addr, detectorName(addr), timing.Frame*4))
+	}
+	d.detectors = detectors
+	d.detectorsLock.Unlock()
+
+	for key, c := range removed {
+		log.Printf("retroarch: no longer detecting on %s\n", key)
+		_ = c.Close()
+
+		if device, ok := d.container.GetDevice(key); ok {
+			log.Printf("%s: disconnecting device '%s'\n", driverName, key)
+			_ = device.Close()
+			d.container.DeleteDevice(key)
+		}
+	}
+}
+
+// replaceDetector replaces a closed detector with a new one unless its address was removed in the meantime
+func (d *Driver) replaceDetector(old *RAClient) *RAClient {
+	d.detectorsLock.Lock()
+	defer d.detectorsLock.Unlock()
+
+	for i, c := range d.detectors {
+		if c == old {
+			c = NewRAClient(old.addr, detectorName(old.addr), timing.Frame*4)
+			d.detectors[i] = c
+			return c
+		}
+	}
+	return nil
 }
 
 func (d *Driver) DisplayOrder() int {
@@ -66,10 +116,56 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_ResetSystem,
 	sni.DeviceCapability_PauseToggleEmulation,
 	sni.DeviceCapability_FetchFields,
+	sni.DeviceCapability_SaveState,
+	sni.DeviceCapability_LoadState,
+	sni.DeviceCapability_Screenshot,
+}
+
+// minimum RetroArch versions of network commands as major*10000 + minor*100 + patch:
+const (
+	// GET_STATUS, which reports the pause state, CLOSE_CONTENT and FRAMEADVANCE:
+	versionStatus = 10900
+	// LOAD_STATE_SLOT:
+	versionLoadStateSlot = 11500
+	// LOAD_CORE and LOAD_CONTENT:
+	versionLoad = 11700
+)
+
+// versionCapabilities are only advertised for RetroArch versions that support their commands
+var versionCapabilities = []struct {
+	version    int
+	capability sni.DeviceCapability
+}{
+	{versionStatus, sni.DeviceCapability_PauseUnpauseEmulation},
+	{versionStatus, sni.DeviceCapability_ResetToMenu},
+	{versionStatus, sni.DeviceCapability_FrameAdvance},
+	{versionLoadStateSlot, sni.DeviceCapability_LoadStateSlot},
+	{versionLoad, sni.DeviceCapability_BootFile},
+}
+
+func makeVersion(major, minor, patch int) int {
+	return major*10000 + minor*100 + patch
+}
+
+func versionString(version int) string {
+	return fmt.Sprintf("%d.%d.%d", version/10000, version/100%100, version%100)
+}
+
+// capabilitiesFor returns the capabilities of a RetroArch with the given version
+func capabilitiesFor(version int) []sni.DeviceCapability {
+	capabilities := append([]sni.DeviceCapability(nil), driverCapabilities...)
+	for _, vc := range versionCapabilities {
+		if version >= vc.version {
+			capabilities = append(capabilities, vc.capability)
+		}
+	}
+	return capabilities
 }
 
+// HasCapabilities checks against the capabilities of the newest RetroArch since the driver does not know which
+// version a request is for; the device itself fails requests its version does not support.
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
-	return devices.CheckCapabilities(capabilities, driverCapabilities)
+	return devices.CheckCapabilities(capabilities, capabilitiesFor(math.MaxInt))
 }
 
 func (d *Driver) openDevice(uri *url.URL) (q devices.Device, err error) {
@@ -99,12 +195,16 @@ func (d *Driver) openDevice(uri *url.URL) (q devices.Device, err error) {
 }
 
 func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
+	d.detectorsLock.Lock()
+	detectors := append([]*RAClient(nil), d.detectors...)
+	d.detectorsLock.Unlock()
+
 	devicesLock := sync.Mutex{}
-	devs = make([]devices.DeviceDescriptor, 0, len(d.detectors))
+	devs = make([]devices.DeviceDescriptor, 0, len(detectors))
 
 	wg := sync.WaitGroup{}
-	wg.Add(len(d.detectors))
-	for i, de := range d.detectors {
+	wg.Add(len(detectors))
+	for i, de := range detectors {
 		// run detectors in parallel:
 		go func(i int, detector *RAClient) {
 			defer func() {
@@ -120,10 +220,11 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 			if detector.IsClosed() {
 				detector.Close()
 				// refresh detector:
-				c := NewRAClient(detector.addr, fmt.Sprintf("retroarch[%d]", i), timing.Frame*4)
-				d.detectors[i] = c
-				c.MuteLog(true)
-				detector = c
+				detector = d.replaceDetector(detector)
+				if detector == nil {
+					return
+				}
+				detector.MuteLog(true)
 			}
 
 			// reconnect detector if necessary:
@@ -137,7 +238,7 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 					}
 					return
 				}
-				if detector.DetectLoopback(d.detectors) {
+				if detector.DetectLoopback(detectors) {
 					detector.Close()
 					if logDetector {
 						log.Printf("retroarch: detect: detector[%d]: loopback connection detected; breaking\n", i)
@@ -163,7 +264,7 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 				Uri:                 url.URL{Scheme: driverName, Host: detector.addr.String()},
 				DisplayName:         fmt.Sprintf("RetroArch v%s (%s)", detector.version, detector.addr),
 				Kind:                d.Kind(),
-				Capabilities:        driverCapabilities[:],
+				Capabilities:        capabilitiesFor(detector.versionNumber),
 				DefaultAddressSpace: defaultAddressSpace,
 				System:              "snes",
 			}
@@ -199,46 +300,13 @@ func (d *Driver) DisconnectAll() {
 }
 
 func DriverInit() {
+	// the driver is registered even when disabled so that clearing retroarch_disable in config.yaml enables it:
 	if config.Config.GetBool("retroarch_disable") {
 		log.Printf("disabling retroarch snes driver\n")
-		return
 	}
 
-	/*
-	  // comma-delimited list of host:port pairs:
-	  hostsStr := env.GetOrSupply("retroarch_hosts", func() string {
-	    // default network_cmd_port for RA is UDP 55355. we want to support connecting to multiple
-	    // instances so let's auto-detect RA instances listening on UDP ports in the range
-	    // [55355..55362]. realistically we probably won't be running any more than a few instances on
-	    // the same machine at one time. i picked 8 since i currently have an 8-core CPU :)
-	    var sb strings.Builder
-	    const count = 1
-	    for i := 0; i < count; i++ {
-	      sb.WriteString(fmt.Sprintf("localhost:%d", 55355+i))
-	      if i < count-1 {
-	        sb.WriteByte(',')
-	      }
-	    }
-	    return sb.String()
-	  })
-	*/
-	hostsStr := config.Config.GetString("retroarch_hosts")
-	// split the hostsStr list by commas:
-	hosts := strings.Split(hostsStr, ",")
-
-	// resolve the addresses:
-	addresses := make([]*net.UDPAddr, 0, len(hosts))
-	for _, host := range hosts {
-		addr, err := net.ResolveUDPAddr("udp", host)
-		if err != nil {
-			log.Printf("retroarch: resolve('%s'): %v\n", host, err)
-			// drop the address if it doesn't resolve:
-			// TODO: consider retrying the resolve later? maybe not worth worrying about.
-			continue
-		}
-
-		addresses = append(addresses, addr)
-	}
+	// comma-delimited list of host:port pairs and host:first-last port ranges:
+	addresses := addressesFromConfig(config.Config)
 
 	if config.Config.GetBool("retroarch_detect_log") {
 		logDetector = true
@@ -248,4 +316,14 @@ func DriverInit() {
 	// register the driver:
 	driver = NewDriver(addresses)
 	devices.Register(driverName, driver)
+
+	// reload the addresses when the configuration file changes:
+	config.ConfigObservable.Subscribe(observable.NewObserver(driverName, func(event observable.Event) {
+		v, ok := event.Value.(*viper.Viper)
+		if !ok || v == nil {
+			return
+		}
+
+		driver.setAddresses(addressesFromConfig(v))
+	}))
 }
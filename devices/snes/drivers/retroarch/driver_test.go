@@ -0,0 +1,59 @@
+package retroarch
+
+import (
+	"math"
+	"sni/protos/sni"
+	"testing"
+)
+
+func TestCapabilitiesFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		want    []sni.DeviceCapability
+		wantNot []sni.DeviceCapability
+	}{
+		{
+			"1.8.0",
+			makeVersion(1, 8, 0),
+			[]sni.DeviceCapability{sni.DeviceCapability_SaveState, sni.DeviceCapability_LoadState},
+			[]sni.DeviceCapability{sni.DeviceCapability_FrameAdvance, sni.DeviceCapability_LoadStateSlot, sni.DeviceCapability_BootFile},
+		},
+		{
+			"1.14.0",
+			makeVersion(1, 14, 0),
+			[]sni.DeviceCapability{sni.DeviceCapability_FrameAdvance},
+			[]sni.DeviceCapability{sni.DeviceCapability_LoadStateSlot, sni.DeviceCapability_BootFile},
+		},
+		{
+			"1.15.0",
+			makeVersion(1, 15, 0),
+			[]sni.DeviceCapability{sni.DeviceCapability_LoadStateSlot},
+			[]sni.DeviceCapability{sni.DeviceCapability_BootFile},
+		},
+		{
+			"newest",
+			math.MaxInt,
+			[]sni.DeviceCapability{sni.DeviceCapability_LoadStateSlot, sni.DeviceCapability_BootFile},
+			[]sni.DeviceCapability{sni.DeviceCapability_SaveStateSlot},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[sni.DeviceCapability]bool)
+			for _, capability := range capabilitiesFor(tt.version) {
+				got[capability] = true
+			}
+			for _, capability := range tt.want {
+				if !got[capability] {
+					t.Errorf("missing %v", capability)
+				}
+			}
+			for _, capability := range tt.wantNot {
+				if got[capability] {
+					t.Errorf("unexpected %v", capability)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package retroarch
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+)
+
+// SaveState saves a state to the current slot. RetroArch has no network command to save to a given slot and does not
+// report its current slot, so other slots are not supported and DeviceCapability_SaveStateSlot is never advertised.
+func (c *RAClient) SaveState(ctx context.Context, slot int) (err error) {
+	if slot != devices.CurrentStateSlot {
+		return devices.WithCode(
+			codes.Unimplemented,
+			fmt.Errorf("retroarch: saving to state slot %d is not supported; omit the slot to use RetroArch's current slot", slot),
+		)
+	}
+	return c.sendCommand(ctx, "SAVE_STATE")
+}
+
+// LoadState loads the state from the current slot with LOAD_STATE or from the given slot with LOAD_STATE_SLOT, which
+// leaves RetroArch's current slot unchanged
+func (c *RAClient) LoadState(ctx context.Context, slot int) (err error) {
+	if slot == devices.CurrentStateSlot {
+		return c.sendCommand(ctx, "LOAD_STATE")
+	}
+	if err = c.requireVersion("LOAD_STATE_SLOT", versionLoadStateSlot); err != nil {
+		return
+	}
+	return c.sendCommand(ctx, fmt.Sprintf("LOAD_STATE_SLOT %d", slot))
+}
+
+// Screenshot saves a screenshot to RetroArch's screenshot directory
+func (c *RAClient) Screenshot(ctx context.Context) (err error) {
+	return c.sendCommand(ctx, "SCREENSHOT")
+}
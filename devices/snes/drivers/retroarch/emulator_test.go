@@ -0,0 +1,60 @@
+package retroarch
+
+import (
+	"context"
+	"errors"
+	"sni/devices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestRAClient_stateSlots(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  int
+		save     bool
+		slot     int
+		wantCode codes.Code
+		want     []string
+	}{
+		{"load current slot", makeVersion(1, 9, 0), false, devices.CurrentStateSlot, codes.OK, []string{"LOAD_STATE"}},
+		{"load slot", versionLoadStateSlot, false, 3, codes.OK, []string{"LOAD_STATE_SLOT 3"}},
+		{"load slot on old version", makeVersion(1, 14, 0), false, 3, codes.Unimplemented, nil},
+		{"save current slot", makeVersion(1, 9, 0), true, devices.CurrentStateSlot, codes.OK, []string{"SAVE_STATE"}},
+		{"save slot", versionLoadStateSlot, true, 3, codes.Unimplemented, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, c := newFakeRetroArch(t, nil)
+			c.stateLock.Lock()
+			c.versionNumber = tt.version
+			c.version = versionString(tt.version)
+			c.stateLock.Unlock()
+
+			var err error
+			if tt.save {
+				err = c.SaveState(context.Background(), tt.slot)
+			} else {
+				err = c.LoadState(context.Background(), tt.slot)
+			}
+			got := codes.OK
+			if err != nil {
+				got = codes.Unknown
+				var coded *devices.CodedError
+				if errors.As(err, &coded) {
+					got = coded.Code
+				}
+			}
+			if got != tt.wantCode {
+				t.Fatalf("code = %v, want %v; err = %v", got, tt.wantCode, err)
+			}
+
+			// RetroArch answers commands in order so once a read is answered every command before it was received:
+			if _, err = c.MultiReadMemory(context.Background(), readRequest(memBase, 1)); err != nil {
+				t.Fatal(err)
+			}
+			assertCommands(t, f, append(tt.want, "READ_CORE_MEMORY 7e0000 1")...)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package retroarch
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"io"
+	"path/filepath"
+	"sni/devices"
+	"strings"
+)
+
+// RetroArch has no access to a device filesystem; only BootFile is supported, which loads a core or content from
+// the filesystem of the machine running RetroArch.
+
+func errFilesystemUnavailable(op string) error {
+	return devices.WithCode(codes.Unimplemented, fmt.Errorf("retroarch: %s is not supported", op))
+}
+
+func (c *RAClient) ReadDirectory(ctx context.Context, path string) ([]devices.DirEntry, error) {
+	return nil, errFilesystemUnavailable("ReadDirectory")
+}
+
+func (c *RAClient) MakeDirectory(ctx context.Context, path string) error {
+	return errFilesystemUnavailable("MakeDirectory")
+}
+
+func (c *RAClient) RemoveFile(ctx context.Context, path string) error {
+	return errFilesystemUnavailable("RemoveFile")
+}
+
+func (c *RAClient) RenameFile(ctx context.Context, path, newFilename string) error {
+	return errFilesystemUnavailable("RenameFile")
+}
+
+func (c *RAClient) PutFile(ctx context.Context, path string, size uint32, r io.Reader, progress devices.ProgressReportFunc) (uint32, error) {
+	return 0, errFilesystemUnavailable("PutFile")
+}
+
+func (c *RAClient) GetFile(ctx context.Context, path string, w io.Writer, sizeReceived devices.SizeReceivedFunc, progress devices.ProgressReportFunc) (uint32, error) {
+	return 0, errFilesystemUnavailable("GetFile")
+}
+
+// isCorePath reports whether path names a libretro core library rather than content
+func isCorePath(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".dll", ".so", ".dylib":
+		return true
+	}
+	return false
+}
+
+// BootFile loads a libretro core with LOAD_CORE if path names a core library or else loads the content at path with
+// LOAD_CONTENT using the current core
+func (c *RAClient) BootFile(ctx context.Context, path string) (err error) {
+	if strings.ContainsAny(path, "\r\n") {
+		return devices.WithCode(codes.InvalidArgument, fmt.Errorf("retroarch: path must not contain line breaks"))
+	}
+
+	command := "LOAD_CONTENT"
+	if isCorePath(path) {
+		command = "LOAD_CORE"
+	}
+	if err = c.requireVersion(command, versionLoad); err != nil {
+		return
+	}
+
+	// the content changes so any cached hash no longer applies:
+	c.romHash.Reset()
+	return c.sendCommand(ctx, command+" "+path)
+}
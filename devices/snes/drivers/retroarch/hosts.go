@@ -0,0 +1,103 @@
+package retroarch
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// maxPortRange limits how many ports a single host:first-last entry may expand to
+const maxPortRange = 64
+
+// expandHosts splits a comma-delimited list of host:port entries into a list of host:port pairs. An entry may give a
+// port range as host:first-last to detect RetroArch instances on each port of the range.
+func expandHosts(list string) (hosts []string) {
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		host, ports, err := net.SplitHostPort(entry)
+		if err != nil {
+			log.Printf("retroarch: hosts: '%s': %v\n", entry, err)
+			continue
+		}
+
+		first, last, isRange := strings.Cut(ports, "-")
+		if !isRange {
+			hosts = append(hosts, entry)
+			continue
+		}
+
+		var firstPort, lastPort uint64
+		firstPort, err = strconv.ParseUint(first, 10, 16)
+		if err == nil {
+			lastPort, err = strconv.ParseUint(last, 10, 16)
+		}
+		if err != nil {
+			log.Printf("retroarch: hosts: '%s': bad port range: %v\n", entry, err)
+			continue
+		}
+		if lastPort < firstPort || lastPort-firstPort >= maxPortRange {
+			log.Printf("retroarch: hosts: '%s': port range must be ascending and span at most %d ports\n", entry, maxPortRange)
+			continue
+		}
+
+		for port := firstPort; port <= lastPort; port++ {
+			hosts = append(hosts, net.JoinHostPort(host, strconv.FormatUint(port, 10)))
+		}
+	}
+
+	return
+}
+
+// addressesFromConfig returns the addresses of retroarch_hosts less those of retroarch_skip_hosts
+func addressesFromConfig(v *viper.Viper) (addresses []*net.UDPAddr) {
+	if v.GetBool("retroarch_disable") {
+		return nil
+	}
+
+	disabled := make(map[string]struct{})
+	for _, addr := range resolveHosts(expandHosts(v.GetString("retroarch_skip_hosts"))) {
+		disabled[addr.String()] = struct{}{}
+	}
+
+	for _, addr := range resolveHosts(expandHosts(v.GetString("retroarch_hosts"))) {
+		if _, ok := disabled[addr.String()]; ok {
+			continue
+		}
+		addresses = append(addresses, addr)
+	}
+	return
+}
+
+// resolveHosts resolves the host:port pairs to distinct UDP addresses; hosts which do not resolve are dropped
+func resolveHosts(hosts []string) (addresses []*net.UDPAddr) {
+	seen := make(map[string]struct{}, len(hosts))
+	addresses = make([]*net.UDPAddr, 0, len(hosts))
+	for _, host := range hosts {
+		addr, err := net.ResolveUDPAddr("udp", host)
+		if err != nil {
+			log.Printf("retroarch: resolve('%s'): %v\n", host, err)
+			continue
+		}
+
+		key := addr.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		addresses = append(addresses, addr)
+	}
+	return
+}
+
+func detectorName(addr *net.UDPAddr) string {
+	return fmt.Sprintf("retroarch[%s]", addr)
+}
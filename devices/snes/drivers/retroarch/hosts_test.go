@@ -0,0 +1,111 @@
+package retroarch
+
+import (
+	"sni/cmd/sni/config"
+	"strings"
+	"testing"
+
+	"github.com/alttpo/observable"
+	"github.com/spf13/viper"
+)
+
+func TestExpandHosts(t *testing.T) {
+	tests := []struct {
+		name string
+		list string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"single", "localhost:55355", []string{"localhost:55355"}},
+		{"list", " localhost:55355 ,, 127.0.0.1:55356", []string{"localhost:55355", "127.0.0.1:55356"}},
+		{"range", "localhost:55355-55357", []string{"localhost:55355", "localhost:55356", "localhost:55357"}},
+		{"single port range", "localhost:55355-55355", []string{"localhost:55355"}},
+		{"ipv6 range", "[::1]:1-2", []string{"[::1]:1", "[::1]:2"}},
+		{"reversed range", "localhost:55357-55355", nil},
+		{"oversized range", "localhost:1000-1064", nil},
+		{"bad range", "localhost:1-x,localhost:70000-70001", nil},
+		{"missing port", "localhost", nil},
+		{"bad entries skipped", "localhost,localhost:2-1,localhost:55355", []string{"localhost:55355"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandHosts(tt.list)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("expandHosts(%q) = %v, want %v", tt.list, got, tt.want)
+			}
+		})
+	}
+
+	// a range may span the maximum number of ports:
+	if got := expandHosts("localhost:1000-1063"); len(got) != maxPortRange || got[maxPortRange-1] != "localhost:1063" {
+		t.Fatalf("expandHosts() = %d hosts ending in %s, want %d", len(got), got[len(got)-1], maxPortRange)
+	}
+}
+
+func TestAddressesFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		hosts   string
+		skip    string
+		disable bool
+		want    []string
+	}{
+		{"hosts", "127.0.0.1:55355,127.0.0.1:55356", "", false, []string{"127.0.0.1:55355", "127.0.0.1:55356"}},
+		{"duplicates", "127.0.0.1:55355,127.0.0.1:55355-55356", "", false, []string{"127.0.0.1:55355", "127.0.0.1:55356"}},
+		{"skip host", "127.0.0.1:55355-55357", "127.0.0.1:55356", false, []string{"127.0.0.1:55355", "127.0.0.1:55357"}},
+		{"skip range", "127.0.0.1:55355-55358", "127.0.0.1:55356-55357", false, []string{"127.0.0.1:55355", "127.0.0.1:55358"}},
+		{"skip all", "127.0.0.1:55355", "127.0.0.1:55355", false, nil},
+		{"unresolvable host", "bad host name:55355,127.0.0.1:55355", "", false, []string{"127.0.0.1:55355"}},
+		{"disabled", "127.0.0.1:55355", "", true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			v.Set("retroarch_hosts", tt.hosts)
+			v.Set("retroarch_skip_hosts", tt.skip)
+			v.Set("retroarch_disable", tt.disable)
+
+			var got []string
+			for _, addr := range addressesFromConfig(v) {
+				got = append(got, addr.String())
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("addressesFromConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDriverInit_disabled(t *testing.T) {
+	config.ConfigObservable = observable.NewObject()
+	config.Config.Set("retroarch_disable", true)
+	config.Config.Set("retroarch_hosts", "127.0.0.1:55355")
+	t.Cleanup(func() {
+		config.Config.Set("retroarch_disable", false)
+		driver.setAddresses(nil)
+	})
+
+	DriverInit()
+	detectors := func() int {
+		driver.detectorsLock.Lock()
+		defer driver.detectorsLock.Unlock()
+		return len(driver.detectors)
+	}
+	if n := detectors(); n != 0 {
+		t.Fatalf("disabled driver detects on %d addresses", n)
+	}
+
+	// clearing retroarch_disable in config.yaml enables detection:
+	v := viper.New()
+	v.Set("retroarch_hosts", "127.0.0.1:55355")
+	config.ConfigObservable.Set(v)
+	if n := detectors(); n != 1 {
+		t.Fatalf("enabled driver detects on %d addresses, want 1", n)
+	}
+
+	v.Set("retroarch_disable", true)
+	config.ConfigObservable.Set(v)
+	if n := detectors(); n != 0 {
+		t.Fatalf("disabled driver detects on %d addresses", n)
+	}
+}
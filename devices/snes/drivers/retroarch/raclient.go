@@ -6,11 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"google.golang.org/grpc/codes"
 	"log"
 	"net"
 	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/devices/snes/mapping"
+	"sni/devices/snes/romhash"
 	"sni/protos/sni"
 	"sni/udpclient"
 	"sni/util"
@@ -38,17 +40,32 @@ type writeOperation struct {
 	ResponseSize int
 }
 
+// rwRequest is a single READ_CORE_MEMORY or WRITE_CORE_MEMORY command, i.e. one fragment of a read or write request
 type rwRequest struct {
+	// index of the read or write request this fragment belongs to and offset of the fragment within it:
 	index    int
+	offset   int
 	deadline time.Time
 
 	isWrite bool
 	command string
 	address uint32
 
+	// seq orders the commands sent; sent is when the command was last sent and attempts is how often:
+	seq      uint64
+	sent     time.Time
+	attempts int
+
 	Read  readOperation
 	Write writeOperation
-	R     chan<- error
+	R     chan<- *rwRequest
+	err   error
+}
+
+// complete reports the outcome of the request to its sender
+func (rwreq *rwRequest) complete(err error) {
+	rwreq.err = err
+	rwreq.R <- rwreq
 }
 
 type RAClient struct {
@@ -62,9 +79,19 @@ type RAClient struct {
 	expectationLock  sync.Mutex
 	outgoing         chan *rwRequest
 	expectedIncoming chan *rwRequest
+	nextSeq          uint64
+
+	// done is closed by Close; outgoingDone is closed once handleOutgoing stops sending commands. outgoing is
+	// unbuffered so that no command is left queued when handleOutgoing stops:
+	done         chan struct{}
+	outgoingDone chan struct{}
 
 	version string
-	useRCR  bool
+	// versionNumber is the parsed version as major*10000 + minor*100 + patch; 0 if unknown
+	versionNumber int
+	useRCR        bool
+
+	romHash romhash.Cache
 
 	closeLock sync.Mutex
 	closed    bool
@@ -78,6 +105,10 @@ func (c *RAClient) NonFatalError(cause error) devices.DeviceError {
 	return devices.DeviceNonFatal(fmt.Sprintf("retroarch: %v", cause), cause)
 }
 
+func (c *RAClient) ProtocolError(cause error) devices.DeviceError {
+	return devices.DeviceFatalKind(devices.ErrorKindProtocolViolation, fmt.Sprintf("retroarch: %v", cause), cause)
+}
+
 // isCloseWorthy returns true if the error should close the connection
 func isCloseWorthy(err error) bool {
 	if errors.Is(err, net.ErrClosed) {
@@ -90,8 +121,10 @@ func NewRAClient(addr *net.UDPAddr, name string, timeout time.Duration) *RAClien
 	c := &RAClient{
 		addr:             addr,
 		readWriteTimeout: timeout,
-		outgoing:         make(chan *rwRequest, 8),
+		outgoing:         make(chan *rwRequest),
 		expectedIncoming: make(chan *rwRequest, 8),
+		done:             make(chan struct{}),
+		outgoingDone:     make(chan struct{}),
 	}
 	udpclient.MakeUDPClient(name, &c.UDPClient)
 
@@ -109,8 +142,7 @@ func (c *RAClient) Close() (err error) {
 
 	if !c.closed {
 		err = c.UDPClient.Close()
-		close(c.outgoing)
-		close(c.expectedIncoming)
+		close(c.done)
 		c.closed = true
 	}
 
@@ -192,11 +224,13 @@ func (c *RAClient) DetermineVersion() (err error) {
 	// parse the version string:
 	var n int
 	var major, minor, patch int
+	c.versionNumber = 0
 	n, err = fmt.Sscanf(c.version, "%d.%d.%d", &major, &minor, &patch)
 	if err != nil || n != 3 {
 		return
 	}
 	err = nil
+	c.versionNumber = makeVersion(major, minor, patch)
 
 	// use READ_CORE_RAM for <= 1.9.0, use READ_CORE_MEMORY otherwise:
 	c.useRCR = false
@@ -259,12 +293,13 @@ func (d *RAClient) GetStatus(ctx context.Context) (raStatus, coreName, romFileNa
 		log.Printf("retroarch: < %s", rsp)
 	}
 
-	// parse the response:
+	// parse the response; CONTENTLESS has no arguments:
 	var args string
 	_, err = fmt.Fscanf(bytes.NewReader(rsp), "GET_STATUS %s %s", &raStatus, &args)
-	if err != nil {
+	if err != nil && raStatus == "" {
 		return
 	}
+	err = nil
 
 	// split the second arg by commas:
 	argsArr := strings.Split(args, ",")
@@ -320,10 +355,22 @@ func (d *RAClient) FetchFields(ctx context.Context, fields ...sni.Field) (values
 			values = append(values, romFileName)
 			break
 		case sni.Field_RomHashType:
-			values = append(values, "crc32")
+			values = append(values, romhash.Type())
 			break
 		case sni.Field_RomHashValue:
-			values = append(values, strconv.FormatUint(uint64(romCRC32), 16))
+			// GET_STATUS already reports the CRC32 so only read the ROM for other hash types:
+			if romhash.Type() == romhash.CRC32 {
+				values = append(values, strconv.FormatUint(uint64(romCRC32), 16))
+				break
+			}
+			var hashValue string
+			_, hashValue, err = d.romHash.Get(ctx, romFileName, func(ctx context.Context) ([]byte, error) {
+				return romhash.ReadROM(ctx, d)
+			})
+			if err != nil {
+				return
+			}
+			values = append(values, hashValue)
 			break
 		default:
 			// unknown value; append empty string to maintain index association:
@@ -338,6 +385,21 @@ func (d *RAClient) FetchFields(ctx context.Context, fields ...sni.Field) (values
 // RA 1.9.0 allows a maximum read size of 2723 bytes so we cut that off at 2048 to make division easier
 const maxReadSize = 2048
 
+// RetroArch receives network commands into a 2048 byte buffer and WRITE_CORE_MEMORY takes three characters per byte
+// written so writes are cut off at 512 bytes
+const maxWriteSize = 512
+
+const (
+	// fragmentTimeout is how long to wait for a response, once the responses to all commands sent earlier arrived,
+	// before the command or its response is presumed lost
+	fragmentTimeout = 250 * time.Millisecond
+	// maxAttempts is how often a read is sent before giving up on it
+	maxAttempts = 3
+)
+
+// errFragmentLost is reported for a command which went unanswered; reads may be sent again
+var errFragmentLost = errors.New("no response")
+
 func (c *RAClient) readCommand() string {
 	c.stateLock.Lock()
 	defer c.stateLock.Unlock()
@@ -397,7 +459,6 @@ func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...devices.MemoryR
 				AddressSpace:  sni.AddressSpace_SnesABus,
 				MemoryMapping: read.RequestAddress.MemoryMapping,
 			},
-			Data: make([]byte, 0, read.Size),
 		}
 
 		mrsp[j].DeviceAddress.Address, err = mapping.TranslateAddress(
@@ -408,93 +469,52 @@ func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...devices.MemoryR
 			return nil, err
 		}
 
-		size := read.Size
-		if size <= 0 {
+		if read.Size <= 0 {
+			mrsp[j].Data = make([]byte, 0)
 			continue
 		}
-		rsp := mrsp[j]
-
-		offs := uint32(0)
-		for size > maxReadSize {
-			// maxReadSize chunk:
-			outgoing = append(outgoing, &rwRequest{
-				index:    j,
-				deadline: deadline,
-				isWrite:  false,
-				Read: readOperation{
-					RequestAddress: devices.AddressTuple{
-						Address:       read.RequestAddress.Address + offs,
-						AddressSpace:  read.RequestAddress.AddressSpace,
-						MemoryMapping: read.RequestAddress.MemoryMapping,
-					},
-					DeviceAddress: devices.AddressTuple{
-						Address:       rsp.DeviceAddress.Address + offs,
-						AddressSpace:  rsp.DeviceAddress.AddressSpace,
-						MemoryMapping: rsp.DeviceAddress.MemoryMapping,
-					},
-					RequestSize:  maxReadSize,
-					ResponseData: rsp.Data,
-				},
-			})
-			offs += maxReadSize
-			size -= maxReadSize
-		}
+		rsp := &mrsp[j]
+		rsp.Data = make([]byte, read.Size)
 
-		// remainder:
-		if size > 0 {
+		// split the read into maxReadSize fragments:
+		for offs := 0; offs < read.Size; offs += maxReadSize {
 			outgoing = append(outgoing, &rwRequest{
 				index:    j,
+				offset:   offs,
 				deadline: deadline,
 				isWrite:  false,
 				Read: readOperation{
 					RequestAddress: devices.AddressTuple{
-						Address:       read.RequestAddress.Address + offs,
+						Address:       read.RequestAddress.Address + uint32(offs),
 						AddressSpace:  read.RequestAddress.AddressSpace,
 						MemoryMapping: read.RequestAddress.MemoryMapping,
 					},
 					DeviceAddress: devices.AddressTuple{
-						Address:       rsp.DeviceAddress.Address + offs,
+						Address:       rsp.DeviceAddress.Address + uint32(offs),
 						AddressSpace:  rsp.DeviceAddress.AddressSpace,
 						MemoryMapping: rsp.DeviceAddress.MemoryMapping,
 					},
-					RequestSize:  size,
-					ResponseData: rsp.Data,
+					RequestSize: min(maxReadSize, read.Size-offs),
 				},
 			})
 		}
 	}
 
-	// make a channel to receive response errors:
-	responses := make(chan error, len(outgoing))
-	defer close(responses)
-
-	// fire off all commands:
-	for _, rwreq := range outgoing {
-		rwreq.R = responses
-		c.outgoing <- rwreq
-	}
+	c.roundTrip(outgoing)
 
-	// await all responses:
-	err = nil
+	// assemble the fragments:
 	for _, rwreq := range outgoing {
-		err = <-responses
-		if err != nil {
-			if derr, ok := err.(*readResponseError); ok {
-				log.Printf("retroarch: read %#v returned error '%s'; filling response with $00\n", derr.Address, derr.Response)
-				// fill response with 00 bytes:
-				rwreq.Read.ResponseData = rwreq.Read.ResponseData[0:rwreq.Read.RequestSize]
-				d := rwreq.Read.ResponseData
-				for i := range d {
-					d[i] = 0
-				}
-				err = nil
-			}
-			if err != nil {
-				return
-			}
+		if derr, ok := rwreq.err.(*readResponseError); ok {
+			// the response is already filled with $00 bytes:
+			log.Printf("retroarch: read %#v returned error '%s'; filling response with $00\n", derr.Address, derr.Response)
+			continue
+		}
+		if rwreq.err != nil {
+			err = rwreq.err
+			return
 		}
 
-		mrsp[rwreq.index].Data = rwreq.Read.ResponseData
+		copy(mrsp[rwreq.index].Data[rwreq.offset:], rwreq.Read.ResponseData)
 	}
 
 	return
@@ -530,98 +550,119 @@ func (c *RAClient) MultiWriteMemory(ctx context.Context, writes ...devices.Memor
 		}
 
 		data := write.Data
-		size := len(data)
-		if size <= 0 {
-			continue
-		}
 		rsp := &mrsp[j]
 
-		offs := uint32(0)
-		for size > maxReadSize {
-			// maxReadSize chunk:
+		// split the write into maxWriteSize fragments:
+		for offs := 0; offs < len(data); offs += maxWriteSize {
+			size := min(maxWriteSize, len(data)-offs)
 			outgoing = append(outgoing, &rwRequest{
 				index:    j,
+				offset:   offs,
 				deadline: deadline,
 				isWrite:  true,
 				Write: writeOperation{
 					RequestAddress: devices.AddressTuple{
-						Address:       write.RequestAddress.Address + offs,
+						Address:       write.RequestAddress.Address + uint32(offs),
 						AddressSpace:  write.RequestAddress.AddressSpace,
 						MemoryMapping: write.RequestAddress.MemoryMapping,
 					},
 					DeviceAddress: devices.AddressTuple{
-						Address:       rsp.DeviceAddress.Address + offs,
+						Address:       rsp.DeviceAddress.Address + uint32(offs),
 						AddressSpace:  rsp.DeviceAddress.AddressSpace,
 						MemoryMapping: rsp.DeviceAddress.MemoryMapping,
 					},
-					RequestData:  data[:maxReadSize],
-					ResponseSize: maxReadSize,
+					RequestData:  data[offs : offs+size],
+					ResponseSize: size,
 				},
 			})
-
-			offs += maxReadSize
-			size -= maxReadSize
-			data = data[maxReadSize:]
 		}
+	}
 
-		// remainder:
-		if size > 0 {
-			outgoing = append(outgoing, &rwRequest{
-				index:    j,
-				deadline: deadline,
-				isWrite:  true,
-				Write: writeOperation{
-					RequestAddress: devices.AddressTuple{
-						Address:       write.RequestAddress.Address + offs,
-						AddressSpace:  write.RequestAddress.AddressSpace,
-						MemoryMapping: write.RequestAddress.MemoryMapping,
-					},
-					DeviceAddress: devices.AddressTuple{
-						Address:       rsp.DeviceAddress.Address + offs,
-						AddressSpace:  rsp.DeviceAddress.AddressSpace,
-						MemoryMapping: rsp.DeviceAddress.MemoryMapping,
-					},
-					RequestData:  data[:size],
-					ResponseSize: size,
-				},
-			})
+	c.roundTrip(outgoing)
+
+	for _, rwreq := range outgoing {
+		if rwreq.err != nil {
+			err = rwreq.err
+			return
 		}
+
+		mrsp[rwreq.index].Size += rwreq.Write.ResponseSize
 	}
 
-	// make a channel to receive response errors:
-	responses := make(chan error, len(outgoing))
-	defer close(responses)
+	return
+}
+
+// roundTrip sends all commands and waits for their responses. Reads which went unanswered are sent again up to
+// maxAttempts times before they fail. Unanswered writes fail right away since only the reply may have been lost and
+// the game may have changed the memory since; the outcome of each command is left in its err field.
+func (c *RAClient) roundTrip(outgoing []*rwRequest) {
+	// every command sent completes exactly once so the channel never holds more than one completion per command:
+	responses := make(chan *rwRequest, len(outgoing))
 
 	// fire off all commands:
 	for _, rwreq := range outgoing {
 		rwreq.R = responses
-		c.outgoing <- rwreq
+		rwreq.attempts = 1
+		c.send(rwreq)
 	}
 
 	// await all responses:
-	err = nil
-	for _, rwreq := range outgoing {
-		err = <-responses
-		if err != nil {
-			return
-		}
+	for pending := len(outgoing); pending > 0; {
+		rwreq := <-responses
+		if rwreq.err == errFragmentLost && rwreq.isWrite {
+			rwreq.err = c.NonFatalError(fmt.Errorf(
+				"%s %06x: no response; the write may or may not have been applied",
+				rwreq.command,
+				rwreq.address,
+			))
+		} else if rwreq.err == errFragmentLost {
+			if rwreq.attempts < maxAttempts && time.Now().Before(rwreq.deadline) {
+				if config.VerboseLogging {
+					log.Printf("retroarch: %s %06x #%d: no response; sending again\n", rwreq.command, rwreq.address, rwreq.seq)
+				}
+				rwreq.attempts++
+				c.send(rwreq)
+				continue
+			}
 
-		mrsp[rwreq.index].Size += rwreq.Write.ResponseSize
+			rwreq.err = c.NonFatalError(fmt.Errorf(
+				"%s %06x: no response after %d attempts",
+				rwreq.command,
+				rwreq.address,
+				rwreq.attempts,
+			))
+		}
+		pending--
 	}
+}
 
-	return
+// send hands the command to handleOutgoing or fails it with net.ErrClosed once the client is closed
+func (c *RAClient) send(rwreq *rwRequest) {
+	select {
+	case c.outgoing <- rwreq:
+	case <-c.done:
+		rwreq.complete(net.ErrClosed)
+	}
 }
 
 func (c *RAClient) handleOutgoing() {
 	defer util.Recover()
+	defer close(c.outgoingDone)
 
-	c.stateLock.Lock()
-	useRCR := c.useRCR
-	c.stateLock.Unlock()
+	for {
+		var rwreq *rwRequest
+		select {
+		case rwreq = <-c.outgoing:
+		case <-c.done:
+			return
+		}
 
-	for rwreq := range c.outgoing {
 		var sb strings.Builder
 
+		c.stateLock.Lock()
+		useRCR := c.useRCR
+		c.stateLock.Unlock()
+
 		// build the proper command to send:
 		if rwreq.isWrite {
 			// write:
@@ -648,51 +689,145 @@ func (c *RAClient) handleOutgoing() {
 		{
 			reqStr := sb.String()
 
+			rwreq.seq = c.nextSeq
+			c.nextSeq++
+			rwreq.sent = time.Now()
 			if config.VerboseLogging {
-				log.Printf("retroarch: > %s", reqStr)
+				log.Printf("retroarch: > #%d %s", rwreq.seq, reqStr)
 			}
 
 			err := c.WriteWithDeadline([]byte(reqStr), rwreq.deadline)
 			if err != nil {
 				c.expectationLock.Unlock()
-				rwreq.R <- err
+				rwreq.complete(err)
 				if isCloseWorthy(err) {
 					_ = c.Close()
 				}
-				return
+				continue
 			}
 
 			if useRCR && rwreq.isWrite {
 				// fake a response since we don't get any from WRITE_CORE_RAM:
-				rwreq.R <- nil
+				rwreq.complete(nil)
 			} else {
 				// we're now expecting an incoming response:
-				c.expectedIncoming <- rwreq
+				select {
+				case c.expectedIncoming <- rwreq:
+				case <-c.done:
+					rwreq.complete(net.ErrClosed)
+				}
 			}
 		}
 		c.expectationLock.Unlock()
 	}
 }
 
+// handleIncoming matches responses to the commands in flight. RetroArch answers commands in the order it receives
+// them and echoes the command and address in each response, so a response belongs to the oldest command in flight
+// with the same command and address; commands sent before that one are presumed lost. Responses matching no
+// command in flight, such as late responses to commands which were sent again, are dropped.
 func (c *RAClient) handleIncoming() {
 	defer util.Recover()
 
-	for rwreq := range c.expectedIncoming {
-		rsp, err := c.ReadWithDeadline(rwreq.deadline)
+	var inflight []*rwRequest
+	defer func() {
+		for _, rwreq := range inflight {
+			rwreq.complete(net.ErrClosed)
+		}
+
+		// fail the commands handleOutgoing sent before it stopped:
+		<-c.outgoingDone
+		for {
+			select {
+			case rwreq := <-c.expectedIncoming:
+				rwreq.complete(net.ErrClosed)
+			default:
+				return
+			}
+		}
+	}()
+
+	var lastResponse time.Time
+	for {
+		if len(inflight) == 0 {
+			select {
+			case rwreq := <-c.expectedIncoming:
+				inflight = append(inflight, rwreq)
+			case <-c.done:
+				return
+			}
+		}
+
+		// collect the commands sent meanwhile:
+	collect:
+		for {
+			select {
+			case rwreq := <-c.expectedIncoming:
+				inflight = append(inflight, rwreq)
+			default:
+				break collect
+			}
+		}
+
+		// wait for the oldest command's response:
+		head := inflight[0]
+		wait := head.sent
+		if lastResponse.After(wait) {
+			wait = lastResponse
+		}
+		wait = wait.Add(fragmentTimeout)
+		if head.deadline.Before(wait) {
+			wait = head.deadline
+		}
+
+		rsp, err := c.ReceiveWithDeadline(wait)
 		if err != nil {
-			rwreq.R <- err
+			var nerr net.Error
+			if errors.As(err, &nerr) && nerr.Timeout() {
+				inflight = inflight[1:]
+				head.complete(errFragmentLost)
+				continue
+			}
+
+			for _, rwreq := range inflight {
+				rwreq.complete(err)
+			}
+			inflight = nil
 			if isCloseWorthy(err) {
 				_ = c.Close()
 			}
-			break
+			continue
 		}
+		lastResponse = time.Now()
 
 		if config.VerboseLogging {
 			log.Printf("retroarch: < %s", rsp)
 		}
 
-		err = c.parseCommandResponse(rsp, rwreq)
-		rwreq.R <- err
+		var cmd string
+		var addr uint32
+		i := -1
+		if n, _ := fmt.Fscanf(bytes.NewReader(rsp), "%s %x", &cmd, &addr); n == 2 {
+			for j, rwreq := range inflight {
+				if rwreq.command == cmd && rwreq.address == addr {
+					i = j
+					break
+				}
+			}
+		}
+		if i < 0 {
+			if config.VerboseLogging {
+				log.Printf("retroarch: dropping response matching no command in flight\n")
+			}
+			continue
+		}
+
+		for _, rwreq := range inflight[:i] {
+			rwreq.complete(errFragmentLost)
+		}
+		rwreq := inflight[i]
+		inflight = inflight[i+1:]
+		rwreq.complete(c.parseCommandResponse(rsp, rwreq))
 	}
 }
 
@@ -709,6 +844,11 @@ func (r *readResponseError) IsFatal() bool {
 	return false
 }
 
+// Kind reports that RetroArch has no memory descriptor covering the address
+func (r *readResponseError) Kind() devices.ErrorKind {
+	return devices.ErrorKindAddressOutOfRange
+}
+
 func (c *RAClient) parseCommandResponse(rsp []byte, rwreq *rwRequest) (err error) {
 	c.stateLock.Lock()
 	useRCR := c.useRCR
@@ -721,7 +861,7 @@ func (c *RAClient) parseCommandResponse(rsp []byte, rwreq *rwRequest) (err error
 	var n int
 	n, err = fmt.Fscanf(r, "%s %x", &cmd, &addr)
 	if n != 2 || cmd != rwreq.command || addr != rwreq.address {
-		err = c.FatalError(fmt.Errorf("expected response starting with `%s %x` but got: `%s`", rwreq.command, rwreq.address, string(rsp)))
+		err = c.ProtocolError(fmt.Errorf("expected response starting with `%s %x` but got: `%s`", rwreq.command, rwreq.address, string(rsp)))
 		return
 	}
 	err = nil
@@ -743,7 +883,7 @@ func (c *RAClient) parseCommandResponse(rsp []byte, rwreq *rwRequest) (err error
 				txt, err = bufio.NewReader(t).ReadString('\n')
 				if err != nil {
 					log.Printf("could not read error text from %s response: %v; `%s`", cmd, err, string(rsp))
-					err = c.FatalError(err)
+					err = c.ProtocolError(err)
 					return
 				}
 
@@ -765,7 +905,7 @@ func (c *RAClient) parseCommandResponse(rsp []byte, rwreq *rwRequest) (err error
 		}
 
 		if wlen != len(rwreq.Write.RequestData) {
-			err = c.FatalError(fmt.Errorf(
+			err = c.ProtocolError(fmt.Errorf(
 				"%s responded with unexpected length %d; expected %d; `%s`",
 				cmd,
 				wlen,
@@ -790,20 +930,21 @@ func (c *RAClient) parseCommandResponse(rsp []byte, rwreq *rwRequest) (err error
 			data = append(data, v)
 		}
 
-		rwreq.Read.ResponseData = append(rwreq.Read.ResponseData, data...)
+		rwreq.Read.ResponseData = data
 
 		err = nil
 		return
 	}
 }
 
-func (c *RAClient) ResetSystem(ctx context.Context) (err error) {
+// sendCommand sends a network command which RetroArch does not reply to
+func (c *RAClient) sendCommand(ctx context.Context, command string) (err error) {
 	deadline, ok := ctx.Deadline()
 	if !ok {
 		deadline = time.Now().Add(c.readWriteTimeout)
 	}
 
-	req := []byte("RESET\n")
+	req := []byte(command + "\n")
 	if config.VerboseLogging {
 		log.Printf("retroarch: > %s", req)
 	}
@@ -811,24 +952,124 @@ func (c *RAClient) ResetSystem(ctx context.Context) (err error) {
 	return
 }
 
-func (c *RAClient) ResetToMenu(ctx context.Context) error {
-	panic("implement me")
+// requireVersion fails with codes.Unimplemented if the connected RetroArch is older than version
+func (c *RAClient) requireVersion(command string, version int) error {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+
+	if c.versionNumber < version {
+		return devices.WithCode(codes.Unimplemented, fmt.Errorf(
+			"retroarch: %s requires RetroArch v%s or newer but found v%s",
+			command,
+			versionString(version),
+			c.version,
+		))
+	}
+	return nil
 }
 
-func (c *RAClient) PauseUnpause(ctx context.Context, pausedState bool) (bool, error) {
-	return false, fmt.Errorf("capability unavailable")
+func (c *RAClient) ResetSystem(ctx context.Context) (err error) {
+	return c.sendCommand(ctx, "RESET")
 }
 
-func (c *RAClient) PauseToggle(ctx context.Context) (err error) {
+// ResetToMenu closes the running content which returns RetroArch to its menu
+func (c *RAClient) ResetToMenu(ctx context.Context) (err error) {
+	if err = c.requireVersion("CLOSE_CONTENT", versionStatus); err != nil {
+		return
+	}
+	return c.sendCommand(ctx, "CLOSE_CONTENT")
+}
+
+// PauseUnpause toggles pause only if GET_STATUS reports a different state than requested so that it is idempotent
+func (c *RAClient) PauseUnpause(ctx context.Context, pausedState bool) (newState bool, err error) {
+	if err = c.requireVersion("GET_STATUS", versionStatus); err != nil {
+		return
+	}
+
+	var raStatus string
+	if raStatus, _, _, _, err = c.GetStatus(ctx); err != nil {
+		return
+	}
+	switch raStatus {
+	case "PAUSED":
+		newState = true
+	case "PLAYING":
+		newState = false
+	default:
+		err = devices.DeviceNonFatal(fmt.Sprintf("retroarch: cannot pause or unpause while %s", raStatus), nil)
+		return
+	}
+	if newState == pausedState {
+		return
+	}
+
+	if err = c.sendCommand(ctx, "PAUSE_TOGGLE"); err != nil {
+		return
+	}
+
+	// confirm the new state since RetroArch does not reply to PAUSE_TOGGLE; the toggle may only take effect on a
+	// later frame than the one GET_STATUS is answered on so keep checking until the state changes:
 	deadline, ok := ctx.Deadline()
 	if !ok {
 		deadline = time.Now().Add(c.readWriteTimeout)
 	}
+	for {
+		if raStatus, _, _, _, err = c.GetStatus(ctx); err != nil {
+			return
+		}
+		newState = raStatus == "PAUSED"
+		if newState == pausedState || time.Now().Add(frameDuration).After(deadline) {
+			return
+		}
+		if err = waitFrame(ctx); err != nil {
+			return
+		}
+	}
+}
 
-	req := []byte("PAUSE_TOGGLE\n")
-	if config.VerboseLogging {
-		log.Printf("retroarch: > %s", req)
+func (c *RAClient) PauseToggle(ctx context.Context) (err error) {
+	return c.sendCommand(ctx, "PAUSE_TOGGLE")
+}
+
+// frameDuration is the duration of one frame at 60 frames per second
+const frameDuration = time.Second / 60
+
+// waitFrame waits for the duration of one frame or until the context is done
+func waitFrame(ctx context.Context) error {
+	t := time.NewTimer(frameDuration)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return devices.WithCode(codes.DeadlineExceeded, ctx.Err())
+	case <-t.C:
+		return nil
+	}
+}
+
+// FrameAdvance pauses emulation if needed and runs the given number of frames
+func (c *RAClient) FrameAdvance(ctx context.Context, frames uint32) (err error) {
+	if err = c.requireVersion("FRAMEADVANCE", versionStatus); err != nil {
+		return
+	}
+	for i := uint32(0); i < frames; i++ {
+		if i > 0 {
+			// RetroArch polls network commands once per frame and advances only a single frame for all FRAMEADVANCE
+			// commands received by one poll, so wait a frame and for a reply to a later poll before the next:
+			if err = waitFrame(ctx); err != nil {
+				return
+			}
+			if _, _, _, _, err = c.GetStatus(ctx); err != nil {
+				return
+			}
+		}
+		if err = c.sendCommand(ctx, "FRAMEADVANCE"); err != nil {
+			return
+		}
 	}
-	err = c.WriteWithDeadline(req, deadline)
 	return
 }
+
+func (c *RAClient) CompareAndWriteMemory(ctx context.Context, request devices.MemoryCompareAndWriteRequest) (devices.MemoryCompareAndWriteResponse, error) {
+	// emulator does not support atomic compare-and-write so fall back to read-compare-write while paused:
+	return devices.CompareAndWriteWhilePaused(ctx, c, request)
+}
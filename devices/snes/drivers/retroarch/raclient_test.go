@@ -0,0 +1,366 @@
+package retroarch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sni/devices"
+	"sni/protos/sni"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memBase is the bus address of the first byte of fakeRetroArch memory
+const memBase = 0x7E0000
+
+// fakeRetroArch answers READ_CORE_MEMORY and WRITE_CORE_MEMORY commands against its memory
+type fakeRetroArch struct {
+	conn *net.UDPConn
+
+	lock     sync.Mutex
+	mem      []byte
+	commands []string
+
+	// hold decides for the n-th command received, counting from 0, whether its response is dropped or held back
+	// until release is closed; a nil release sends the response right away
+	hold func(n int) (release <-chan struct{}, drop bool)
+	// sent receives the number of each command once its held back response was sent
+	sent chan int
+}
+
+func newFakeRetroArch(t *testing.T, hold func(n int) (<-chan struct{}, bool)) (*fakeRetroArch, *RAClient) {
+	t.Helper()
+
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	f := &fakeRetroArch{
+		conn: conn,
+		mem:  make([]byte, 0x10000),
+		hold: hold,
+		sent: make(chan int, 16),
+	}
+	for i := range f.mem {
+		f.mem[i] = byte(i * 7)
+	}
+	go f.serve()
+
+	addr := conn.LocalAddr().(*net.UDPAddr)
+	c := NewRAClient(addr, "retroarch", time.Second)
+	if err = c.Connect(addr); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+
+	return f, c
+}
+
+func (f *fakeRetroArch) serve() {
+	b := make([]byte, 65536)
+	for n := 0; ; n++ {
+		size, raddr, err := f.conn.ReadFromUDP(b)
+		if err != nil {
+			return
+		}
+
+		rsp := f.execute(strings.TrimSpace(string(b[:size])))
+
+		var release <-chan struct{}
+		var drop bool
+		if f.hold != nil {
+			release, drop = f.hold(n)
+		}
+		if drop {
+			continue
+		}
+		if release == nil {
+			_, _ = f.conn.WriteToUDP(rsp, raddr)
+			continue
+		}
+
+		go func(n int) {
+			<-release
+			_, _ = f.conn.WriteToUDP(rsp, raddr)
+			f.sent <- n
+		}(n)
+	}
+}
+
+// execute runs the command against memory and returns RetroArch's response to it
+func (f *fakeRetroArch) execute(command string) []byte {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.commands = append(f.commands, command)
+
+	fields := strings.Fields(command)
+	var addr uint32
+	if len(fields) > 1 {
+		_, _ = fmt.Sscanf(fields[1], "%x", &addr)
+	}
+	offs := int(addr - memBase)
+
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "%s %06x", fields[0], addr)
+	switch fields[0] {
+	case "READ_CORE_MEMORY":
+		var size int
+		_, _ = fmt.Sscanf(fields[2], "%d", &size)
+		for _, v := range f.mem[offs : offs+size] {
+			_, _ = fmt.Fprintf(&sb, " %02x", v)
+		}
+	case "WRITE_CORE_MEMORY":
+		for i, h := range fields[2:] {
+			_, _ = fmt.Sscanf(h, "%02x", &f.mem[offs+i])
+		}
+		_, _ = fmt.Fprintf(&sb, " %d", len(fields)-2)
+	}
+	sb.WriteByte('\n')
+
+	return []byte(sb.String())
+}
+
+// commandsSent returns the command name, address and size or length of each command received
+func (f *fakeRetroArch) commandsSent() (sent []string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for _, command := range f.commands {
+		fields := strings.Fields(command)
+		if fields[0] == "WRITE_CORE_MEMORY" {
+			sent = append(sent, fmt.Sprintf("%s %s %d", fields[0], fields[1], len(fields)-2))
+		} else {
+			sent = append(sent, strings.Join(fields, " "))
+		}
+	}
+	return
+}
+
+func (f *fakeRetroArch) memory(address uint32, size int) []byte {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	offs := int(address - memBase)
+	return append([]byte(nil), f.mem[offs:offs+size]...)
+}
+
+func readRequest(address uint32, size int) devices.MemoryReadRequest {
+	return devices.MemoryReadRequest{
+		RequestAddress: devices.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_Raw},
+		Size:           size,
+	}
+}
+
+func assertCommands(t *testing.T, f *fakeRetroArch, want ...string) {
+	t.Helper()
+
+	got := f.commandsSent()
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("commands sent:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestRAClient_fragments(t *testing.T) {
+	f, c := newFakeRetroArch(t, nil)
+
+	data := make([]byte, 1200)
+	for i := range data {
+		data[i] = byte(0xFF - i)
+	}
+	wrsp, err := c.MultiWriteMemory(context.Background(), devices.MemoryWriteRequest{
+		RequestAddress: devices.AddressTuple{Address: memBase + 0x100, AddressSpace: sni.AddressSpace_Raw},
+		Data:           data,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wrsp[0].Size != len(data) {
+		t.Fatalf("wrote %d bytes, want %d", wrsp[0].Size, len(data))
+	}
+
+	rrsp, err := c.MultiReadMemory(context.Background(), readRequest(memBase, 5000), readRequest(memBase+0x8000, 16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(rrsp[0].Data, f.memory(memBase, 5000)) {
+		t.Fatal("first read does not match memory")
+	}
+	if !bytes.Equal(rrsp[0].Data[0x100:0x100+len(data)], data) {
+		t.Fatal("first read does not return the data written")
+	}
+	if !bytes.Equal(rrsp[1].Data, f.memory(memBase+0x8000, 16)) {
+		t.Fatal("second read does not match memory")
+	}
+
+	assertCommands(t, f,
+		"WRITE_CORE_MEMORY 7e0100 512",
+		"WRITE_CORE_MEMORY 7e0300 512",
+		"WRITE_CORE_MEMORY 7e0500 176",
+		"READ_CORE_MEMORY 7e0000 2048",
+		"READ_CORE_MEMORY 7e0800 2048",
+		"READ_CORE_MEMORY 7e1000 904",
+		"READ_CORE_MEMORY 7e8000 16",
+	)
+}
+
+func TestRAClient_droppedResponse(t *testing.T) {
+	// drop the response to the second fragment; the response to the third shows it was lost:
+	f, c := newFakeRetroArch(t, func(n int) (<-chan struct{}, bool) {
+		return nil, n == 1
+	})
+
+	rsp, err := c.MultiReadMemory(context.Background(), readRequest(memBase, 5000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(rsp[0].Data, f.memory(memBase, 5000)) {
+		t.Fatal("read does not match memory")
+	}
+
+	assertCommands(t, f,
+		"READ_CORE_MEMORY 7e0000 2048",
+		"READ_CORE_MEMORY 7e0800 2048",
+		"READ_CORE_MEMORY 7e1000 904",
+		"READ_CORE_MEMORY 7e0800 2048",
+	)
+}
+
+func TestRAClient_unanswered(t *testing.T) {
+	f, c := newFakeRetroArch(t, func(n int) (<-chan struct{}, bool) {
+		return nil, true
+	})
+
+	_, err := c.MultiReadMemory(context.Background(), readRequest(memBase, 16))
+	if err == nil {
+		t.Fatal("read without responses succeeded")
+	}
+	if devices.IsFatal(err) {
+		t.Fatalf("expected non-fatal error; got %v", err)
+	}
+	if c.IsClosed() {
+		t.Fatal("client closed after commands went unanswered")
+	}
+
+	want := make([]string, maxAttempts)
+	for i := range want {
+		want[i] = "READ_CORE_MEMORY 7e0000 16"
+	}
+	assertCommands(t, f, want...)
+}
+
+func TestRAClient_unansweredWrite(t *testing.T) {
+	// drop the response to the second fragment; it must not be written again:
+	f, c := newFakeRetroArch(t, func(n int) (<-chan struct{}, bool) {
+		return nil, n == 1
+	})
+
+	_, err := c.MultiWriteMemory(context.Background(), devices.MemoryWriteRequest{
+		RequestAddress: devices.AddressTuple{Address: memBase, AddressSpace: sni.AddressSpace_Raw},
+		Data:           make([]byte, 1200),
+	})
+	if err == nil {
+		t.Fatal("write without a response succeeded")
+	}
+	if devices.IsFatal(err) {
+		t.Fatalf("expected non-fatal error; got %v", err)
+	}
+
+	assertCommands(t, f,
+		"WRITE_CORE_MEMORY 7e0000 512",
+		"WRITE_CORE_MEMORY 7e0200 512",
+		"WRITE_CORE_MEMORY 7e0400 176",
+	)
+}
+
+func TestRAClient_lateResponse(t *testing.T) {
+	// hold back the response to the first read until it was sent again and a read of another address is in flight:
+	lateRelease := make(chan struct{})
+	otherRelease := make(chan struct{})
+	f, c := newFakeRetroArch(t, func(n int) (<-chan struct{}, bool) {
+		switch n {
+		case 0:
+			return lateRelease, false
+		case 2:
+			return otherRelease, false
+		default:
+			return nil, false
+		}
+	})
+
+	rsp, err := c.MultiReadMemory(context.Background(), readRequest(memBase, 16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(rsp[0].Data, f.memory(memBase, 16)) {
+		t.Fatal("first read does not match memory")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		rsp, err = c.MultiReadMemory(context.Background(), readRequest(memBase+0x100, 16))
+		done <- err
+	}()
+
+	// wait for the second read to be sent before the late response arrives:
+	for len(f.commandsSent()) < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	close(lateRelease)
+	<-f.sent
+	close(otherRelease)
+	<-f.sent
+
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(rsp[0].Data, f.memory(memBase+0x100, 16)) {
+		t.Fatal("second read does not match memory")
+	}
+
+	assertCommands(t, f,
+		"READ_CORE_MEMORY 7e0000 16",
+		"READ_CORE_MEMORY 7e0000 16",
+		"READ_CORE_MEMORY 7e0100 16",
+	)
+}
+
+func TestRAClient_closed(t *testing.T) {
+	_, c := newFakeRetroArch(t, nil)
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := c.MultiReadMemory(context.Background(), readRequest(memBase, 5000))
+	if !errors.Is(err, net.ErrClosed) {
+		t.Fatalf("read after close: got %v, want %v", err, net.ErrClosed)
+	}
+}
+
+func TestRAClient_closeWhileResending(t *testing.T) {
+	// close the client while the unanswered fragments are being sent again:
+	resending := make(chan struct{})
+	var resendingOnce sync.Once
+	_, c := newFakeRetroArch(t, func(n int) (<-chan struct{}, bool) {
+		if n >= 3 {
+			resendingOnce.Do(func() { close(resending) })
+		}
+		return nil, true
+	})
+	go func() {
+		<-resending
+		_ = c.Close()
+	}()
+
+	_, err := c.MultiReadMemory(context.Background(), readRequest(memBase, 5000))
+	if !errors.Is(err, net.ErrClosed) {
+		t.Fatalf("read while closing: got %v, want %v", err, net.ErrClosed)
+	}
+}
@@ -0,0 +1,86 @@
+// Package bsx translates addresses for the Satellaview BS-X cartridge.
+//
+// FX Pak Pro address space layout:
+//
+//	$000000-$0FFFFF: memory pack (flash)
+//	$400000-$47FFFF: PSRAM
+//	$E00000-$E07FFF: SRAM (battery-backed)
+//	$F50000-$F6FFFF: WRAM
+//
+// SNES A-bus layout assumes the MCC configuration the BS-X BIOS sets up when executing a downloaded game from PSRAM:
+//
+//	$00-$3F,$80-$BF:$8000-$FFFF: PSRAM in LoROM layout
+//	$10-$17:$5000-$5FFF:         SRAM
+//	$60-$6F:$0000-$FFFF:         PSRAM (mirrored)
+//	$70-$77:$0000-$FFFF:         PSRAM
+//	$7E-$7F:$0000-$FFFF:         WRAM
+//	$C0-$FF:$0000-$FFFF:         memory pack in HiROM layout
+package bsx
+
+import (
+	"github.com/alttpo/snes/mapping/util"
+)
+
+const (
+	pakMemoryPack = 0x000000
+	pakPSRAM      = 0x400000
+	pakSRAM       = 0xE00000
+	pakWRAM       = 0xF50000
+
+	sizeMemoryPack = 0x100000
+	sizePSRAM      = 0x080000
+	sizeSRAM       = 0x008000
+)
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	bank := busAddr >> 16
+	offs := busAddr & 0xFFFF
+
+	if bank >= 0xC0 && bank <= 0xFF {
+		// memory pack access:   $C0:0000-$FF:FFFF
+		return pakMemoryPack + (busAddr-0xC00000)&(sizeMemoryPack-1), nil
+	} else if bank >= 0x7E && bank <= 0x7F {
+		// WRAM access:          $7E:0000-$7F:FFFF
+		return pakWRAM + (busAddr - 0x7E0000), nil
+	} else if bank >= 0x70 && bank <= 0x77 {
+		// PSRAM access:         $70:0000-$77:FFFF
+		return pakPSRAM + (busAddr - 0x700000), nil
+	} else if bank >= 0x60 && bank <= 0x6F {
+		// PSRAM mirror:         $60:0000-$6F:FFFF
+		return pakPSRAM + (busAddr-0x600000)&(sizePSRAM-1), nil
+	} else if bank&0x7F < 0x40 {
+		if offs >= 0x8000 {
+			// PSRAM as LoROM:   $00:8000-$3F:FFFF
+			return pakPSRAM + util.BankToLinear(busAddr&0x3F7FFF)&(sizePSRAM-1), nil
+		} else if offs < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$3F:1FFF
+			return pakWRAM + (busAddr & 0x1FFF), nil
+		} else if bank >= 0x10 && bank <= 0x17 && offs >= 0x5000 && offs < 0x6000 {
+			// SRAM access:      $10:5000-$17:5FFF
+			return pakSRAM + ((bank - 0x10) << 12) + (offs - 0x5000), nil
+		}
+	}
+
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= pakWRAM && pakAddr < 0x1_000000 {
+		// mirror bank $F7..FF back down into WRAM like the other mappings do:
+		busAddr = ((pakAddr - pakWRAM) & 0x01FFFF) + 0x7E0000
+		return
+	} else if pakAddr >= pakSRAM && pakAddr < pakSRAM+sizeSRAM {
+		// SRAM is split into 4KiB chunks at $5000-$5FFF in banks $10-$17:
+		offs := pakAddr - pakSRAM
+		busAddr = ((0x10 + (offs >> 12)) << 16) + 0x5000 + (offs & 0x0FFF)
+		return
+	} else if pakAddr >= pakPSRAM && pakAddr < pakPSRAM+sizePSRAM {
+		busAddr = (pakAddr - pakPSRAM) + 0x700000
+		return
+	} else if pakAddr >= pakMemoryPack && pakAddr < pakMemoryPack+sizeMemoryPack {
+		busAddr = (pakAddr - pakMemoryPack) + 0xC00000
+		return
+	}
+
+	return 0, util.ErrUnmappedAddress
+}
@@ -0,0 +1,76 @@
+package bsx
+
+import "testing"
+
+func TestBusAddressToPak(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{name: "memory pack header", busAddr: 0xC07FB0, want: 0x007FB0},
+		{name: "memory pack last byte", busAddr: 0xCFFFFF, want: 0x0FFFFF},
+		{name: "memory pack mirror", busAddr: 0xD00000, want: 0x000000},
+		{name: "PSRAM LoROM bank $00", busAddr: 0x008000, want: 0x400000},
+		{name: "PSRAM LoROM bank $80", busAddr: 0x80FFB0, want: 0x407FB0},
+		{name: "PSRAM LoROM mirror", busAddr: 0x108000, want: 0x400000},
+		{name: "PSRAM direct", busAddr: 0x771234, want: 0x471234},
+		{name: "PSRAM direct mirror", busAddr: 0x681234, want: 0x401234},
+		{name: "SRAM bank $10", busAddr: 0x105000, want: 0xE00000},
+		{name: "SRAM bank $17", busAddr: 0x175FFF, want: 0xE07FFF},
+		{name: "WRAM", busAddr: 0x7E0010, want: 0xF50010},
+		{name: "WRAM lower mirror", busAddr: 0x001FFF, want: 0xF51FFF},
+		{name: "unmapped I/O", busAddr: 0x002100, wantErr: true},
+		{name: "unmapped $40", busAddr: 0x400000, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BusAddressToPak(tt.busAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BusAddressToPak() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BusAddressToPak() = $%06x, want $%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPakAddressToBus(t *testing.T) {
+	tests := []struct {
+		name    string
+		pakAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{name: "memory pack header", pakAddr: 0x007FB0, want: 0xC07FB0},
+		{name: "PSRAM", pakAddr: 0x471234, want: 0x771234},
+		{name: "SRAM", pakAddr: 0xE01234, want: 0x115234},
+		{name: "WRAM", pakAddr: 0xF50010, want: 0x7E0010},
+		{name: "unmapped", pakAddr: 0x800000, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PakAddressToBus(tt.pakAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PakAddressToBus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PakAddressToBus() = $%06x, want $%06x", got, tt.want)
+			}
+			if err != nil {
+				return
+			}
+
+			// must round-trip:
+			back, err := BusAddressToPak(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if back != tt.pakAddr {
+				t.Errorf("BusAddressToPak(PakAddressToBus()) = $%06x, want $%06x", back, tt.pakAddr)
+			}
+		})
+	}
+}
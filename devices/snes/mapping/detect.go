@@ -61,6 +61,15 @@ func Detect(
 
 	confidence = true
 
+	if isBSXHeader(&header) {
+		mapping = sni.MemoryMapping_BSX
+		log.Printf(
+			"detect: detected mapping mode = %s\n",
+			sni.MemoryMapping_name[int32(mapping)],
+		)
+		return
+	}
+
 	// mask off SlowROM vs FastROM bit:
 	switch header.MapMode & 0b1110_1111 {
 	case 0x20: // LoROM
@@ -101,6 +110,27 @@ func Detect(
 	return
 }
 
+// isBSXHeader checks for a Satellaview memory pack header which differs in layout from a standard SNES header:
+func isBSXHeader(header *snes.Header) bool {
+	// $FFDA is fixed at $33 (or $FF when erased):
+	if header.OldMakerCode != 0x33 && header.OldMakerCode != 0xFF {
+		return false
+	}
+
+	// $FFD8 holds the map mode instead of $FFD5; only LoROM or HiROM with slow or fast ROM is valid:
+	if header.RAMSize&0b1110_1110 != 0x20 {
+		return false
+	}
+
+	// $FFD6 holds the broadcast month in the upper nibble:
+	month := header.CartridgeType
+	if month&0x0F != 0 || month>>4 > 12 {
+		return false
+	}
+
+	return true
+}
+
 func detectHeader(ctx context.Context, memory devices.DeviceMemory) (outHeaderBytes []byte, err error) {
 	addresses := [3]uint32{
 		uint32(0x007FB0),
@@ -112,6 +142,7 @@ func detectHeader(ctx context.Context, memory devices.DeviceMemory) (outHeaderBy
 		sni.MemoryMapping_HiROM,
 		sni.MemoryMapping_ExHiROM,
 		sni.MemoryMapping_SA1,
+		sni.MemoryMapping_BSX,
 	}
 
 	bestScore := 0
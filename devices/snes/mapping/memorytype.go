@@ -6,6 +6,7 @@ import (
 	"github.com/alttpo/snes/mapping/lorom"
 	"github.com/alttpo/snes/mapping/sa1rom"
 	"sni/devices"
+	"sni/devices/snes/mapping/bsx"
 	"sni/protos/sni"
 )
 
@@ -17,8 +18,27 @@ const (
 	MemoryTypeROM     MemoryType = "CARTROM"
 	MemoryTypeSRAM    MemoryType = "SRAM"
 	MemoryTypeWRAM    MemoryType = "WRAM"
+	MemoryTypeVRAM    MemoryType = "VRAM"
+	MemoryTypeAPU     MemoryType = "APURAM"
+	MemoryTypeCGRAM   MemoryType = "CGRAM"
+	MemoryTypeOAM     MemoryType = "OAM"
+	// the following have no emunw-access equivalent and are only meaningful to FX Pak Pro:
+	MemoryTypePSRAM  MemoryType = "PSRAM"
+	MemoryTypeMISC   MemoryType = "MISC"
+	MemoryTypePPUREG MemoryType = "PPUREG"
+	MemoryTypeCPUREG MemoryType = "CPUREG"
 )
 
+// HasEmulatorDomain returns true if the memory type has an equivalent memory domain exposed by emulators
+func (m MemoryType) HasEmulatorDomain() bool {
+	switch m {
+	case MemoryTypeUnknown, MemoryTypePSRAM, MemoryTypeMISC, MemoryTypePPUREG, MemoryTypeCPUREG:
+		return false
+	default:
+		return true
+	}
+}
+
 func MemoryTypeFor(a devices.AddressTuple) (memoryType MemoryType, pakAddress uint32, offset uint32) {
 	var err error
 
@@ -36,6 +56,8 @@ func MemoryTypeFor(a devices.AddressTuple) (memoryType MemoryType, pakAddress ui
 			pakAddress, err = exhirom.BusAddressToPak(a.Address)
 		case sni.MemoryMapping_SA1:
 			pakAddress, err = sa1rom.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_BSX:
+			pakAddress, err = bsx.BusAddressToPak(a.Address)
 		}
 	case sni.AddressSpace_Raw:
 		err = ErrUnknownMapping
@@ -47,11 +69,29 @@ func MemoryTypeFor(a devices.AddressTuple) (memoryType MemoryType, pakAddress ui
 		return
 	}
 
-	memoryType, offset = MemoryTypeForPakAddress(pakAddress)
+	memoryType, offset = MemoryTypeForPakAddressWithMapping(pakAddress, a.MemoryMapping)
 	return
 }
 
 func MemoryTypeForPakAddress(pakAddress uint32) (memoryType MemoryType, offset uint32) {
+	return MemoryTypeForPakAddressWithMapping(pakAddress, sni.MemoryMapping_Unknown)
+}
+
+// MemoryTypeForPakAddressWithMapping is MemoryTypeForPakAddress but accounts for mappings with special layouts
+func MemoryTypeForPakAddressWithMapping(pakAddress uint32, memoryMapping sni.MemoryMapping) (memoryType MemoryType, offset uint32) {
+	if memoryMapping == sni.MemoryMapping_BSX {
+		if pakAddress < 0x10_0000 {
+			memoryType, offset = MemoryTypeROM, pakAddress
+			return
+		} else if pakAddress >= 0x40_0000 && pakAddress < 0x48_0000 {
+			memoryType, offset = MemoryTypePSRAM, pakAddress-0x40_0000
+			return
+		} else if pakAddress < 0xE0_0000 {
+			memoryType, offset = MemoryTypeUnknown, pakAddress
+			return
+		}
+	}
+
 	if pakAddress < 0xE0_0000 {
 		memoryType, offset = MemoryTypeROM, pakAddress
 	} else if pakAddress < 0xF0_0000 {
@@ -61,9 +101,22 @@ func MemoryTypeForPakAddress(pakAddress uint32) (memoryType MemoryType, offset u
 		memoryType, offset = MemoryTypeUnknown, pakAddress-0xF0_0000
 	} else if pakAddress < 0xF7_0000 {
 		memoryType, offset = MemoryTypeWRAM, pakAddress-0xF5_0000
+	} else if pakAddress < 0xF8_0000 {
+		memoryType, offset = MemoryTypeVRAM, pakAddress-0xF7_0000
+	} else if pakAddress < 0xF9_0000 {
+		memoryType, offset = MemoryTypeAPU, pakAddress-0xF8_0000
+	} else if pakAddress < 0xF9_0200 {
+		memoryType, offset = MemoryTypeCGRAM, pakAddress-0xF9_0000
+	} else if pakAddress < 0xF9_0420 {
+		memoryType, offset = MemoryTypeOAM, pakAddress-0xF9_0200
+	} else if pakAddress < 0xF9_0500 {
+		memoryType, offset = MemoryTypeMISC, pakAddress-0xF9_0420
+	} else if pakAddress < 0xF9_0700 {
+		memoryType, offset = MemoryTypePPUREG, pakAddress-0xF9_0500
+	} else if pakAddress < 0xF9_0900 {
+		memoryType, offset = MemoryTypeCPUREG, pakAddress-0xF9_0700
 	} else {
-		// TODO: VRAM, APU, CGRAM, OAM, MISC, PPUREG, CPUREG, etc.
-		memoryType, offset = MemoryTypeUnknown, pakAddress-0xF7_0000
+		memoryType, offset = MemoryTypeUnknown, pakAddress-0xF9_0900
 	}
 	return
 }
@@ -0,0 +1,78 @@
+package mapping
+
+import (
+	"sni/protos/sni"
+	"testing"
+)
+
+func TestMemoryTypeForPakAddressWithMapping(t *testing.T) {
+	tests := []struct {
+		name          string
+		pakAddress    uint32
+		memoryMapping sni.MemoryMapping
+		wantType      MemoryType
+		wantOffset    uint32
+	}{
+		{name: "ROM start", pakAddress: 0x000000, wantType: MemoryTypeROM, wantOffset: 0x000000},
+		{name: "ROM end", pakAddress: 0xDFFFFF, wantType: MemoryTypeROM, wantOffset: 0xDFFFFF},
+		{name: "SRAM start", pakAddress: 0xE00000, wantType: MemoryTypeSRAM, wantOffset: 0x000000},
+		{name: "SRAM end", pakAddress: 0xEFFFFF, wantType: MemoryTypeSRAM, wantOffset: 0x0FFFFF},
+		{name: "unmapped below WRAM", pakAddress: 0xF00000, wantType: MemoryTypeUnknown, wantOffset: 0x000000},
+		{name: "WRAM start", pakAddress: 0xF50000, wantType: MemoryTypeWRAM, wantOffset: 0x000000},
+		{name: "WRAM end", pakAddress: 0xF6FFFF, wantType: MemoryTypeWRAM, wantOffset: 0x01FFFF},
+		{name: "VRAM start", pakAddress: 0xF70000, wantType: MemoryTypeVRAM, wantOffset: 0x000000},
+		{name: "APU start", pakAddress: 0xF80000, wantType: MemoryTypeAPU, wantOffset: 0x000000},
+		{name: "CGRAM start", pakAddress: 0xF90000, wantType: MemoryTypeCGRAM, wantOffset: 0x000000},
+		{name: "CGRAM end", pakAddress: 0xF901FF, wantType: MemoryTypeCGRAM, wantOffset: 0x0001FF},
+		{name: "OAM start", pakAddress: 0xF90200, wantType: MemoryTypeOAM, wantOffset: 0x000000},
+		{name: "OAM end", pakAddress: 0xF9041F, wantType: MemoryTypeOAM, wantOffset: 0x00021F},
+		{name: "MISC start", pakAddress: 0xF90420, wantType: MemoryTypeMISC, wantOffset: 0x000000},
+		{name: "PPUREG start", pakAddress: 0xF90500, wantType: MemoryTypePPUREG, wantOffset: 0x000000},
+		{name: "CPUREG start", pakAddress: 0xF90700, wantType: MemoryTypeCPUREG, wantOffset: 0x000000},
+		{name: "unmapped above CPUREG", pakAddress: 0xF90900, wantType: MemoryTypeUnknown, wantOffset: 0x000000},
+		{name: "BSX memory pack", pakAddress: 0x0FFFFF, memoryMapping: sni.MemoryMapping_BSX, wantType: MemoryTypeROM, wantOffset: 0x0FFFFF},
+		{name: "BSX unmapped", pakAddress: 0x200000, memoryMapping: sni.MemoryMapping_BSX, wantType: MemoryTypeUnknown, wantOffset: 0x200000},
+		{name: "BSX PSRAM start", pakAddress: 0x400000, memoryMapping: sni.MemoryMapping_BSX, wantType: MemoryTypePSRAM, wantOffset: 0x000000},
+		{name: "BSX PSRAM end", pakAddress: 0x47FFFF, memoryMapping: sni.MemoryMapping_BSX, wantType: MemoryTypePSRAM, wantOffset: 0x07FFFF},
+		{name: "BSX SRAM", pakAddress: 0xE00010, memoryMapping: sni.MemoryMapping_BSX, wantType: MemoryTypeSRAM, wantOffset: 0x000010},
+		{name: "BSX WRAM", pakAddress: 0xF50010, memoryMapping: sni.MemoryMapping_BSX, wantType: MemoryTypeWRAM, wantOffset: 0x000010},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotType, gotOffset := MemoryTypeForPakAddressWithMapping(tt.pakAddress, tt.memoryMapping)
+			if gotType != tt.wantType {
+				t.Errorf("MemoryTypeForPakAddressWithMapping() memoryType = %s, want %s", gotType, tt.wantType)
+			}
+			if gotOffset != tt.wantOffset {
+				t.Errorf("MemoryTypeForPakAddressWithMapping() offset = $%06x, want $%06x", gotOffset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestMemoryType_HasEmulatorDomain(t *testing.T) {
+	tests := []struct {
+		memoryType MemoryType
+		want       bool
+	}{
+		{MemoryTypeUnknown, false},
+		{MemoryTypeROM, true},
+		{MemoryTypeSRAM, true},
+		{MemoryTypeWRAM, true},
+		{MemoryTypeVRAM, true},
+		{MemoryTypeAPU, true},
+		{MemoryTypeCGRAM, true},
+		{MemoryTypeOAM, true},
+		{MemoryTypePSRAM, false},
+		{MemoryTypeMISC, false},
+		{MemoryTypePPUREG, false},
+		{MemoryTypeCPUREG, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.memoryType), func(t *testing.T) {
+			if got := tt.memoryType.HasEmulatorDomain(); got != tt.want {
+				t.Errorf("HasEmulatorDomain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
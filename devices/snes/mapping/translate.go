@@ -7,6 +7,7 @@ import (
 	"github.com/alttpo/snes/mapping/lorom"
 	"github.com/alttpo/snes/mapping/sa1rom"
 	"sni/devices"
+	"sni/devices/snes/mapping/bsx"
 	"sni/protos/sni"
 )
 
@@ -36,6 +37,8 @@ func TranslateAddress(
 				return exhirom.PakAddressToBus(address)
 			case sni.MemoryMapping_SA1:
 				return sa1rom.PakAddressToBus(address)
+			case sni.MemoryMapping_BSX:
+				return bsx.PakAddressToBus(address)
 			default:
 				return 0, ErrUnknownMapping
 			}
@@ -56,6 +59,8 @@ func TranslateAddress(
 				return exhirom.BusAddressToPak(address)
 			case sni.MemoryMapping_SA1:
 				return sa1rom.BusAddressToPak(address)
+			case sni.MemoryMapping_BSX:
+				return bsx.BusAddressToPak(address)
 			default:
 				return 0, ErrUnknownMapping
 			}
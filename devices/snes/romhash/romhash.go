@@ -0,0 +1,238 @@
+package romhash
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/alttpo/snes"
+	"google.golang.org/grpc/codes"
+	"hash"
+	"hash/crc32"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+	"strings"
+	"sync"
+)
+
+// hash types as reported in the RomHashType field:
+const (
+	CRC32  = "crc32"
+	MD5    = "md5"
+	SHA1   = "sha1"
+	SHA256 = "sha256"
+)
+
+const (
+	// ROM occupies the FX Pak Pro address space up to SRAM:
+	romMaxSize = 0xE00000
+	// readChunkSize keeps each read within a single LoROM bank:
+	readChunkSize = 0x8000
+)
+
+// Type returns the configured hash type, CRC32 if none is configured
+func Type() string {
+	hashType := strings.ToLower(strings.TrimSpace(config.Config.GetString("rom_hash_type")))
+	if hashType == "" {
+		return CRC32
+	}
+	return hashType
+}
+
+// New creates a hash.Hash for the given hash type
+func New(hashType string) (h hash.Hash, err error) {
+	switch hashType {
+	case CRC32:
+		h = crc32.NewIEEE()
+	case MD5:
+		h = md5.New()
+	case SHA1:
+		h = sha1.New()
+	case SHA256:
+		h = sha256.New()
+	default:
+		err = devices.WithCode(codes.FailedPrecondition, fmt.Errorf("romhash: unsupported hash type %q", hashType))
+	}
+	return
+}
+
+// Sum hashes the ROM contents and returns the lowercase hex digest
+func Sum(hashType string, contents []byte) (value string, err error) {
+	var h hash.Hash
+	if h, err = New(hashType); err != nil {
+		return
+	}
+	_, _ = h.Write(contents)
+	value = hex.EncodeToString(h.Sum(nil))
+	return
+}
+
+// ReadROM reads the loaded ROM through the FX Pak Pro address space. The size is taken from the ROM header which
+// is located with the same heuristics clients use to detect the memory mapping, and the mirroring which fills out a
+// ROM whose size is not a power of two is removed so that the contents match the ROM file.
+func ReadROM(ctx context.Context, memory devices.DeviceMemory) (contents []byte, err error) {
+	var memoryMapping sni.MemoryMapping
+	var headerBytes []byte
+	memoryMapping, _, headerBytes, err = mapping.Detect(ctx, memory, nil, nil)
+	if err != nil {
+		return
+	}
+
+	header := snes.Header{}
+	if err = header.ReadHeader(bytes.NewReader(headerBytes)); err != nil {
+		return
+	}
+
+	if header.ROMSize == 0 || header.ROMSize > 0x0E {
+		err = devices.DeviceNonFatal(fmt.Sprintf("romhash: invalid ROM size $%02x in header", header.ROMSize), nil)
+		return
+	}
+	size := 0x400 << header.ROMSize
+	if size > romMaxSize {
+		size = romMaxSize
+	}
+
+	reads := make([]devices.MemoryReadRequest, 0, size/readChunkSize+1)
+	for addr := 0; addr < size; addr += readChunkSize {
+		n := size - addr
+		if n > readChunkSize {
+			n = readChunkSize
+		}
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       uint32(addr),
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: memoryMapping,
+			},
+			Size: n,
+		})
+	}
+
+	var rsps []devices.MemoryReadResponse
+	rsps, err = memory.MultiReadMemory(ctx, reads...)
+	if err != nil {
+		return
+	}
+
+	contents = make([]byte, 0, size)
+	for _, rsp := range rsps {
+		contents = append(contents, rsp.Data...)
+	}
+	if size&(size-1) == 0 {
+		contents = contents[:unmirroredSize(contents)]
+	}
+	return
+}
+
+// Mirror fills out a ROM whose size is not a power of two up to the next power of two the way cartridges and
+// emulators map it: the part beyond the largest power of two is repeated until it fills the upper half.
+func Mirror(contents []byte) []byte {
+	size := readChunkSize
+	for size < len(contents) {
+		size <<= 1
+	}
+	return mirror(contents, size)
+}
+
+func mirror(contents []byte, size int) []byte {
+	if len(contents) >= size {
+		return append([]byte(nil), contents[:size]...)
+	}
+	half := size / 2
+	if len(contents) <= half {
+		lower := mirror(contents, half)
+		return append(lower, lower...)
+	}
+	return append(append([]byte(nil), contents[:half]...), mirror(contents[half:], half)...)
+}
+
+// unmirroredSize undoes Mirror by finding the size of the ROM within contents whose length is a power of two. A
+// half which repeats the other half is mirrored unless it is all the same byte, which is taken to be padding that is
+// part of the ROM file.
+func unmirroredSize(contents []byte) int {
+	if len(contents) <= readChunkSize {
+		return len(contents)
+	}
+	half := len(contents) / 2
+	if bytes.Equal(contents[:half], contents[half:]) && !isUniform(contents[:half]) {
+		return unmirroredSize(contents[:half])
+	}
+	return half + unmirroredSize(contents[half:])
+}
+
+func isUniform(b []byte) bool {
+	for _, c := range b {
+		if c != b[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// HeaderKey identifies the loaded ROM by its header for devices which do not report the ROM file name
+func HeaderKey(ctx context.Context, memory devices.DeviceMemory) (key string, err error) {
+	var headerBytes []byte
+	_, _, headerBytes, err = mapping.Detect(ctx, memory, nil, nil)
+	if err != nil {
+		return
+	}
+
+	key = hex.EncodeToString(headerBytes)
+	return
+}
+
+// Cache remembers the hash of the loaded ROM until the ROM changes or a different hash type is configured
+type Cache struct {
+	lock     sync.Mutex
+	romKey   string
+	hashType string
+	value    string
+}
+
+// Get returns the configured hash type and the hash of the ROM identified by romKey, usually its file name. read
+// is only called to fetch the ROM contents when the cached hash is stale. An empty romKey means no ROM is loaded.
+func (c *Cache) Get(
+	ctx context.Context,
+	romKey string,
+	read func(ctx context.Context) ([]byte, error),
+) (hashType string, value string, err error) {
+	hashType = Type()
+	if _, err = New(hashType); err != nil {
+		return
+	}
+	if romKey == "" {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.romKey == romKey && c.hashType == hashType {
+		value = c.value
+		return
+	}
+
+	var contents []byte
+	if contents, err = read(ctx); err != nil {
+		return
+	}
+	if value, err = Sum(hashType, contents); err != nil {
+		return
+	}
+
+	c.romKey, c.hashType, c.value = romKey, hashType, value
+	return
+}
+
+// Reset forgets the cached hash so that the next Get reads the ROM again
+func (c *Cache) Reset() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.romKey, c.hashType, c.value = "", "", ""
+}
@@ -0,0 +1,55 @@
+package romhash
+
+import (
+	"bytes"
+	"testing"
+)
+
+func makeContents(size int) []byte {
+	contents := make([]byte, size)
+	for i := range contents {
+		contents[i] = byte(i>>8) ^ byte(i>>16)
+	}
+	return contents
+}
+
+func TestMirror(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int
+		mirrorSize int
+	}{
+		{name: "power of two", size: 0x100000, mirrorSize: 0x100000},
+		{name: "1.5 MiB", size: 0x180000, mirrorSize: 0x200000},
+		{name: "2.5 MiB", size: 0x280000, mirrorSize: 0x400000},
+		{name: "3 MiB", size: 0x300000, mirrorSize: 0x400000},
+		{name: "3.5 MiB", size: 0x380000, mirrorSize: 0x400000},
+		{name: "6 MiB", size: 0x600000, mirrorSize: 0x800000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contents := makeContents(tt.size)
+			mirrored := Mirror(contents)
+			if len(mirrored) != tt.mirrorSize {
+				t.Fatalf("Mirror() size = $%x, want $%x", len(mirrored), tt.mirrorSize)
+			}
+			if !bytes.Equal(mirrored[:tt.size], contents) {
+				t.Fatal("Mirror() changed the ROM contents")
+			}
+			if actual, expected := unmirroredSize(mirrored), tt.size; actual != expected {
+				t.Fatalf("unmirroredSize() = $%x, want $%x", actual, expected)
+			}
+		})
+	}
+}
+
+func TestUnmirroredSize_padding(t *testing.T) {
+	// a ROM padded out to a power of two with $FF is not mirrored:
+	contents := makeContents(0x400000)
+	for i := 0x200000; i < len(contents); i++ {
+		contents[i] = 0xFF
+	}
+	if actual, expected := unmirroredSize(contents), len(contents); actual != expected {
+		t.Fatalf("unmirroredSize() = $%x, want $%x", actual, expected)
+	}
+}
@@ -0,0 +1,323 @@
+package symbols
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alttpo/snes"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/yaml.v3"
+	"log"
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Device is the subset of a device needed to read symbols
+type Device interface {
+	devices.DeviceMemory
+	devices.DeviceInfo
+}
+
+// number accepts integers written as numbers or as decimal, "0x" or "$" prefixed hexadecimal strings since JSON has
+// no hexadecimal literals
+type number uint64
+
+func parseNumber(s string) (n number, err error) {
+	s = strings.TrimSpace(s)
+	var v uint64
+	if strings.HasPrefix(s, "$") {
+		v, err = strconv.ParseUint(s[1:], 16, 64)
+	} else {
+		v, err = strconv.ParseUint(s, 0, 64)
+	}
+	n = number(v)
+	return
+}
+
+func (n *number) UnmarshalYAML(value *yaml.Node) (err error) {
+	*n, err = parseNumber(value.Value)
+	return
+}
+
+func (n *number) UnmarshalJSON(b []byte) (err error) {
+	s := string(b)
+	if unquoted, uerr := strconv.Unquote(s); uerr == nil {
+		s = unquoted
+	}
+	*n, err = parseNumber(s)
+	return
+}
+
+// symbolFile is the layout of a symbol in a symbol map file
+type symbolFile struct {
+	Address      number `yaml:"address" json:"address"`
+	AddressSpace string `yaml:"addressSpace" json:"addressSpace"`
+	Size         int    `yaml:"size" json:"size"`
+	Type         Type   `yaml:"type" json:"type"`
+	Mask         number `yaml:"mask" json:"mask"`
+	Description  string `yaml:"description" json:"description"`
+}
+
+// mapFile is the layout of a symbol map file
+type mapFile struct {
+	Titles  []string              `yaml:"titles" json:"titles"`
+	Hashes  []string              `yaml:"hashes" json:"hashes"`
+	Symbols map[string]symbolFile `yaml:"symbols" json:"symbols"`
+}
+
+// Dir returns the directory symbol map files are loaded from
+func Dir() string {
+	return filepath.Join(config.Dir, "symbols")
+}
+
+// parse decodes a YAML or JSON symbol map file
+func parse(name string, ext string, b []byte) (m *Map, err error) {
+	var f mapFile
+	if ext == ".json" {
+		err = json.Unmarshal(b, &f)
+	} else {
+		err = yaml.Unmarshal(b, &f)
+	}
+	if err != nil {
+		return
+	}
+
+	m = &Map{
+		Name:    name,
+		Titles:  f.Titles,
+		Hashes:  make([]string, 0, len(f.Hashes)),
+		Symbols: make(map[string]*Symbol, len(f.Symbols)),
+	}
+	for _, hash := range f.Hashes {
+		m.Hashes = append(m.Hashes, strings.ToLower(hash))
+	}
+	for symbolName, sf := range f.Symbols {
+		s := &Symbol{
+			Name:         symbolName,
+			Address:      uint32(sf.Address),
+			AddressSpace: sni.AddressSpace_FxPakPro,
+			Size:         sf.Size,
+			Type:         Type(strings.ToLower(string(sf.Type))),
+			Mask:         uint64(sf.Mask),
+			Description:  sf.Description,
+		}
+		if sf.AddressSpace != "" {
+			space, ok := sni.AddressSpace_value[sf.AddressSpace]
+			if !ok {
+				err = fmt.Errorf("symbol %q: unknown address space %q", symbolName, sf.AddressSpace)
+				return
+			}
+			s.AddressSpace = sni.AddressSpace(space)
+		}
+		if err = s.validate(); err != nil {
+			return
+		}
+		m.Symbols[symbolName] = s
+	}
+
+	return
+}
+
+type cachedFile struct {
+	modTime time.Time
+	size    int64
+	m       *Map
+}
+
+var (
+	cacheLock sync.Mutex
+	fileCache = make(map[string]cachedFile)
+)
+
+// Load returns the symbol maps in Dir ordered by name. Files are only parsed again when they change; files which
+// fail to parse are logged and skipped.
+func Load() (maps []*Map, err error) {
+	var entries []os.DirEntry
+	entries, err = os.ReadDir(Dir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+
+		path := filepath.Join(Dir(), entry.Name())
+		var fi os.FileInfo
+		if fi, err = entry.Info(); err != nil {
+			return
+		}
+		if c, ok := fileCache[path]; ok && c.modTime.Equal(fi.ModTime()) && c.size == fi.Size() {
+			maps = append(maps, c.m)
+			continue
+		}
+
+		var b []byte
+		if b, err = os.ReadFile(path); err != nil {
+			return
+		}
+		var m *Map
+		if m, err = parse(strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())), ext, b); err != nil {
+			log.Printf("symbols: %s: %v\n", path, err)
+			err = nil
+			continue
+		}
+
+		fileCache[path] = cachedFile{modTime: fi.ModTime(), size: fi.Size(), m: m}
+		maps = append(maps, m)
+	}
+
+	sort.Slice(maps, func(i, j int) bool { return maps[i].Name < maps[j].Name })
+	return
+}
+
+// ByName returns the symbol map loaded from the file with the given name
+func ByName(name string) (m *Map, err error) {
+	var maps []*Map
+	if maps, err = Load(); err != nil {
+		return
+	}
+	for _, m = range maps {
+		if m.Name == name {
+			return
+		}
+	}
+	m = nil
+	err = devices.WithCode(codes.NotFound, fmt.Errorf("symbols: symbol map %q not found", name))
+	return
+}
+
+// resolved remembers which symbol map applies to the ROM loaded on a device
+type resolved struct {
+	romFileName string
+	m           *Map
+}
+
+var (
+	resolvedLock sync.Mutex
+	resolvedMaps = make(map[string]resolved)
+)
+
+// Resolve finds the symbol map for the ROM loaded on the device identified by uri by matching the ROM hash and then
+// the ROM header's title. The match is remembered until the device reports a different ROM file name.
+func Resolve(ctx context.Context, uri string, device Device) (m *Map, err error) {
+	var values []string
+	if values, err = device.FetchFields(ctx, sni.Field_RomFileName); err != nil {
+		return
+	}
+	romFileName := values[0]
+
+	var maps []*Map
+	if maps, err = Load(); err != nil {
+		return
+	}
+
+	resolvedLock.Lock()
+	r, ok := resolvedMaps[uri]
+	resolvedLock.Unlock()
+	if ok && romFileName != "" && r.romFileName == romFileName {
+		// make sure the map was not removed or reloaded since:
+		for _, lm := range maps {
+			if lm == r.m {
+				return r.m, nil
+			}
+		}
+	}
+
+	if m, err = match(ctx, device, maps); err != nil {
+		return
+	}
+
+	resolvedLock.Lock()
+	resolvedMaps[uri] = resolved{romFileName: romFileName, m: m}
+	resolvedLock.Unlock()
+	return
+}
+
+func match(ctx context.Context, device Device, maps []*Map) (m *Map, err error) {
+	if len(maps) == 0 {
+		err = devices.WithCode(codes.NotFound, fmt.Errorf("symbols: no symbol maps found in %s", Dir()))
+		return
+	}
+
+	// a hash identifies the exact ROM whereas several versions or hacks of a game may share a title, so match
+	// hashes first if any map lists them:
+	hashed := false
+	for _, m = range maps {
+		hashed = hashed || len(m.Hashes) > 0
+	}
+	if hashed {
+		var values []string
+		if values, err = device.FetchFields(ctx, sni.Field_RomHashValue); err != nil {
+			if devices.IsFatal(err) {
+				return
+			}
+			values = []string{""}
+		}
+		err = nil
+		if hash := strings.ToLower(values[0]); hash != "" {
+			for _, m = range maps {
+				for _, h := range m.Hashes {
+					if h == hash {
+						return
+					}
+				}
+			}
+		}
+	}
+
+	var headerBytes []byte
+	if _, _, headerBytes, err = mapping.Detect(ctx, device, nil, nil); err == nil {
+		header := snes.Header{}
+		if err = header.ReadHeader(bytes.NewReader(headerBytes)); err != nil {
+			return
+		}
+		title := strings.TrimRight(string(header.Title[:]), " \x00")
+		for _, m = range maps {
+			for _, t := range m.Titles {
+				if strings.TrimSpace(t) == title {
+					return
+				}
+			}
+		}
+	} else if devices.IsFatal(err) {
+		return
+	}
+	err = nil
+
+	m = nil
+	err = devices.WithCode(codes.NotFound, fmt.Errorf("symbols: no symbol map matches the loaded ROM"))
+	return
+}
+
+// Lookup returns the named symbols of the map
+func (m *Map) Lookup(names ...string) (symbols []*Symbol, err error) {
+	symbols = make([]*Symbol, 0, len(names))
+	for _, name := range names {
+		s, ok := m.Symbols[name]
+		if !ok {
+			err = devices.WithCode(codes.NotFound, fmt.Errorf("symbols: symbol %q not found in symbol map %q", name, m.Name))
+			return
+		}
+		symbols = append(symbols, s)
+	}
+	return
+}
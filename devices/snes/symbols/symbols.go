@@ -0,0 +1,185 @@
+package symbols
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"sni/devices"
+	"sni/protos/sni"
+	"sort"
+)
+
+// Type describes how a symbol's bytes decode to a value
+type Type string
+
+const (
+	// U8 is an unsigned byte
+	U8 Type = "u8"
+	// U16LE is an unsigned little-endian 16-bit word
+	U16LE Type = "u16le"
+	// Bitfield is a little-endian integer of Size bytes masked by Mask and shifted down to the mask's lowest bit
+	Bitfield Type = "bitfield"
+	// BCD is Size bytes of packed binary-coded decimal with the least significant byte first
+	BCD Type = "bcd"
+)
+
+// Symbol is a named variable in a game's memory
+type Symbol struct {
+	Name         string
+	Address      uint32
+	AddressSpace sni.AddressSpace
+	Size         int
+	Type         Type
+	Mask         uint64
+	Description  string
+}
+
+// Map is a game's symbol map
+type Map struct {
+	// Name is the symbol map's file name without its extension
+	Name string
+	// Titles and Hashes identify the ROMs the map applies to
+	Titles  []string
+	Hashes  []string
+	Symbols map[string]*Symbol
+}
+
+// Value is a symbol read from a device
+type Value struct {
+	Symbol *Symbol
+	Data   []byte
+	Value  uint64
+}
+
+// validate fills in the default size for the symbol's type and checks the size fits the type
+func (s *Symbol) validate() error {
+	if s.Size < 0 {
+		return fmt.Errorf("symbol %q: size must not be negative", s.Name)
+	}
+
+	switch s.Type {
+	case U8:
+		if s.Size == 0 {
+			s.Size = 1
+		}
+		if s.Size != 1 {
+			return fmt.Errorf("symbol %q: u8 must have size 1", s.Name)
+		}
+	case U16LE:
+		if s.Size == 0 {
+			s.Size = 2
+		}
+		if s.Size != 2 {
+			return fmt.Errorf("symbol %q: u16le must have size 2", s.Name)
+		}
+	case Bitfield:
+		if s.Size == 0 {
+			s.Size = 1
+		}
+		if s.Size > 8 {
+			return fmt.Errorf("symbol %q: bitfield must be at most 8 bytes", s.Name)
+		}
+		if s.Mask == 0 {
+			s.Mask = 1<<(8*uint(s.Size)) - 1
+		}
+	case BCD:
+		if s.Size == 0 {
+			s.Size = 1
+		}
+		if s.Size > 9 {
+			return fmt.Errorf("symbol %q: bcd must be at most 9 bytes", s.Name)
+		}
+	default:
+		return fmt.Errorf("symbol %q: unknown type %q", s.Name, s.Type)
+	}
+	return nil
+}
+
+// Decode converts the symbol's bytes to a value
+func (s *Symbol) Decode(data []byte) (v uint64) {
+	switch s.Type {
+	case U8:
+		v = uint64(data[0])
+	case U16LE:
+		v = uint64(data[0]) | uint64(data[1])<<8
+	case Bitfield:
+		for i := len(data) - 1; i >= 0; i-- {
+			v = v<<8 | uint64(data[i])
+		}
+		v = (v & s.Mask) >> bits.TrailingZeros64(s.Mask)
+	case BCD:
+		for i := len(data) - 1; i >= 0; i-- {
+			v = v*100 + uint64(data[i]>>4)*10 + uint64(data[i]&0x0F)
+		}
+	}
+	return
+}
+
+// coalesce merges the symbols' overlapping and adjacent ranges into as few reads as possible
+func coalesce(symbols []*Symbol, memoryMapping sni.MemoryMapping) (reads []devices.MemoryReadRequest) {
+	sorted := append([]*Symbol(nil), symbols...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].AddressSpace != sorted[j].AddressSpace {
+			return sorted[i].AddressSpace < sorted[j].AddressSpace
+		}
+		return sorted[i].Address < sorted[j].Address
+	})
+
+	for _, s := range sorted {
+		if n := len(reads); n > 0 {
+			last := &reads[n-1]
+			end := last.RequestAddress.Address + uint32(last.Size)
+			if last.RequestAddress.AddressSpace == s.AddressSpace && s.Address <= end {
+				if symbolEnd := s.Address + uint32(s.Size); symbolEnd > end {
+					last.Size += int(symbolEnd - end)
+				}
+				continue
+			}
+		}
+
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       s.Address,
+				AddressSpace:  s.AddressSpace,
+				MemoryMapping: memoryMapping,
+			},
+			Size: s.Size,
+		})
+	}
+	return
+}
+
+// Read reads the symbols from the device in a single MultiReadMemory call and decodes their values
+func Read(ctx context.Context, memory devices.DeviceMemory, symbols []*Symbol, memoryMapping sni.MemoryMapping) (values []Value, err error) {
+	reads := coalesce(symbols, memoryMapping)
+
+	var rsps []devices.MemoryReadResponse
+	if rsps, err = memory.MultiReadMemory(ctx, reads...); err != nil {
+		return
+	}
+	if len(rsps) != len(reads) {
+		err = fmt.Errorf("symbols: expected %d read responses but got %d", len(reads), len(rsps))
+		return
+	}
+
+	values = make([]Value, 0, len(symbols))
+	for _, s := range symbols {
+		for j := range reads {
+			start := reads[j].RequestAddress.Address
+			if reads[j].RequestAddress.AddressSpace != s.AddressSpace || s.Address < start || s.Address >= start+uint32(reads[j].Size) {
+				continue
+			}
+
+			offset := int(s.Address - start)
+			data := rsps[j].Data
+			if offset+s.Size > len(data) {
+				err = fmt.Errorf("symbols: short read for symbol %q", s.Name)
+				return
+			}
+			data = append([]byte(nil), data[offset:offset+s.Size]...)
+			values = append(values, Value{Symbol: s, Data: data, Value: s.Decode(data)})
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,236 @@
+package symbols
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"sni/devices/snes/drivers/mock"
+	"sni/protos/sni"
+	"strings"
+	"testing"
+)
+
+func TestSymbol_Decode(t *testing.T) {
+	tests := []struct {
+		symbol Symbol
+		data   []byte
+		want   uint64
+	}{
+		{Symbol{Name: "u8", Type: U8}, []byte{0xA5}, 0xA5},
+		{Symbol{Name: "u16le", Type: U16LE}, []byte{0x34, 0x12}, 0x1234},
+		{Symbol{Name: "flag", Type: Bitfield, Mask: 0x40}, []byte{0x40}, 1},
+		{Symbol{Name: "nibble", Type: Bitfield, Size: 2, Mask: 0x0F00}, []byte{0xFF, 0x3A}, 0xA},
+		{Symbol{Name: "bcd", Type: BCD, Size: 3}, []byte{0x56, 0x34, 0x12}, 123456},
+	}
+	for _, tt := range tests {
+		t.Run(tt.symbol.Name, func(t *testing.T) {
+			s := tt.symbol
+			if err := s.validate(); err != nil {
+				t.Fatal(err)
+			}
+			if got := s.Decode(tt.data); got != tt.want {
+				t.Errorf("Decode() = %#x, want %#x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSymbol_validate(t *testing.T) {
+	for _, s := range []Symbol{
+		{Name: "u8", Type: U8, Size: 2},
+		{Name: "u16le", Type: U16LE, Size: 1},
+		{Name: "bitfield", Type: Bitfield, Size: 9},
+		{Name: "unknown", Type: "s32"},
+	} {
+		if err := s.validate(); err == nil {
+			t.Errorf("validate(%q) expected error", s.Name)
+		}
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	syms := []*Symbol{
+		{Name: "c", Address: 0xF50012, AddressSpace: sni.AddressSpace_FxPakPro, Size: 2},
+		{Name: "a", Address: 0xF50010, AddressSpace: sni.AddressSpace_FxPakPro, Size: 2},
+		{Name: "b", Address: 0xF50011, AddressSpace: sni.AddressSpace_FxPakPro, Size: 1},
+		{Name: "d", Address: 0xF50100, AddressSpace: sni.AddressSpace_FxPakPro, Size: 1},
+		{Name: "e", Address: 0x7E0010, AddressSpace: sni.AddressSpace_SnesABus, Size: 1},
+	}
+
+	reads := coalesce(syms, sni.MemoryMapping_LoROM)
+	if len(reads) != 3 {
+		t.Fatalf("coalesce() = %d reads, want 3", len(reads))
+	}
+	if r := reads[0]; r.RequestAddress.Address != 0xF50010 || r.Size != 4 {
+		t.Errorf("reads[0] = %s size %d, want $F50010 size 4", &r.RequestAddress, r.Size)
+	}
+	if r := reads[1]; r.RequestAddress.Address != 0xF50100 || r.Size != 1 {
+		t.Errorf("reads[1] = %s size %d, want $F50100 size 1", &r.RequestAddress, r.Size)
+	}
+	if r := reads[2]; r.RequestAddress.AddressSpace != sni.AddressSpace_SnesABus || r.RequestAddress.MemoryMapping != sni.MemoryMapping_LoROM {
+		t.Errorf("reads[2] = %s, want SNES A-bus with LoROM mapping", &r.RequestAddress)
+	}
+}
+
+const testYAML = `
+titles: ["SYMBOL TEST"]
+symbols:
+  health:
+    address: $F5F36D
+    type: u8
+    description: current health
+  rupees:
+    address: 0xF5F360
+    type: u16le
+  sword:
+    address: 0xF5F359
+    type: bitfield
+    mask: 0x06
+  score:
+    address: 16101472
+    type: bcd
+    size: 2
+`
+
+const testJSON = `{
+  "hashes": ["DEADBEEF"],
+  "symbols": {
+    "lives": {"address": "$7E0010", "addressSpace": "SnesABus", "type": "u8"}
+  }
+}`
+
+func writeMaps(t *testing.T) {
+	t.Helper()
+
+	config.Dir = t.TempDir()
+	if err := os.MkdirAll(Dir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(Dir(), "test.yaml"), []byte(testYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(Dir(), "other.json"), []byte(testJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// invalid files are skipped:
+	if err := os.WriteFile(filepath.Join(Dir(), "broken.yml"), []byte("symbols: [1, 2"), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	writeMaps(t)
+
+	maps, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(maps) != 2 || maps[0].Name != "other" || maps[1].Name != "test" {
+		t.Fatalf("Load() = %+v", maps)
+	}
+
+	other := maps[0]
+	if other.Hashes[0] != "deadbeef" {
+		t.Errorf("hash = %q, want lowercase", other.Hashes[0])
+	}
+	if s := other.Symbols["lives"]; s.Address != 0x7E0010 || s.AddressSpace != sni.AddressSpace_SnesABus || s.Size != 1 {
+		t.Errorf("lives = %+v", s)
+	}
+
+	test := maps[1]
+	if s := test.Symbols["health"]; s.Address != 0xF5F36D || s.AddressSpace != sni.AddressSpace_FxPakPro || s.Description != "current health" {
+		t.Errorf("health = %+v", s)
+	}
+	if s := test.Symbols["score"]; s.Address != 0xF5B060 || s.Size != 2 {
+		t.Errorf("score = %+v", s)
+	}
+
+	// unchanged files are served from the cache:
+	again, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again[1] != test {
+		t.Error("Load() parsed an unchanged file again")
+	}
+}
+
+func TestResolveRead(t *testing.T) {
+	writeMaps(t)
+	ctx := context.Background()
+
+	d := &mock.Device{}
+	d.Init()
+	t.Cleanup(func() { _ = d.Close() })
+
+	if err := d.LoadROM(ctx, "symbols.sfc", mock.LoROMImage("SYMBOL TEST", 0x10000)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.PauseUnpause(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := Resolve(ctx, "mock:", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "test" {
+		t.Fatalf("Resolve() = %q, want test", m.Name)
+	}
+
+	d.Memory[0xF5F36D] = 0x18
+	d.Memory[0xF5F360], d.Memory[0xF5F361] = 0xE7, 0x03
+	d.Memory[0xF5F359] = 0x04
+	d.Memory[0xF5B060], d.Memory[0xF5B061] = 0x99, 0x12
+
+	syms, err := m.Lookup("health", "rupees", "sword", "score")
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := Read(ctx, d, syms, sni.MemoryMapping_LoROM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uint64{0x18, 999, 2, 1299}
+	for i, v := range values {
+		if v.Value != want[i] {
+			t.Errorf("%s = %d, want %d", v.Symbol.Name, v.Value, want[i])
+		}
+	}
+
+	if _, err = m.Lookup("missing"); err == nil {
+		t.Error("Lookup() expected error for a missing symbol")
+	}
+}
+
+func TestResolve_hashBeforeTitle(t *testing.T) {
+	writeMaps(t)
+	ctx := context.Background()
+
+	d := &mock.Device{}
+	d.Init()
+	t.Cleanup(func() { _ = d.Close() })
+
+	if err := d.LoadROM(ctx, "symbols.sfc", mock.LoROMImage("SYMBOL TEST", 0x10000)); err != nil {
+		t.Fatal(err)
+	}
+	values, err := d.FetchFields(ctx, sni.Field_RomHashValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a map for this exact ROM is preferred over the map for any ROM with the same title:
+	hashed := "hashes: [\"" + strings.ToUpper(values[0]) + "\"]\nsymbols:\n  health:\n    address: $F5F36E\n    type: u8\n"
+	if err = os.WriteFile(filepath.Join(Dir(), "version.yaml"), []byte(hashed), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := Resolve(ctx, "mock:", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "version" {
+		t.Fatalf("Resolve() = %q, want version", m.Name)
+	}
+}
@@ -0,0 +1,209 @@
+package devices
+
+import (
+	"log"
+	"sni/util"
+	"sync"
+	"time"
+)
+
+type DeviceEventType int
+
+const (
+	DeviceAdded DeviceEventType = iota
+	DeviceRemoved
+	DeviceChanged
+)
+
+type DeviceEvent struct {
+	Type       DeviceEventType
+	Descriptor DeviceDescriptor
+}
+
+// DeviceWatcher runs a single detection loop over all registered drivers for as long as it has
+// subscribers and broadcasts the differences between successive detections to each subscriber.
+type DeviceWatcher struct {
+	interval time.Duration
+
+	lock        sync.Mutex
+	subscribers map[chan []DeviceEvent]struct{}
+	stop        chan struct{}
+	// known devices per driver name as of the last detection:
+	known    map[string][]DeviceDescriptor
+	detected bool
+}
+
+const defaultWatchInterval = time.Second
+
+// Watcher is the shared DeviceWatcher used by all services
+var Watcher = NewDeviceWatcher(defaultWatchInterval)
+
+func NewDeviceWatcher(interval time.Duration) *DeviceWatcher {
+	return &DeviceWatcher{
+		interval:    interval,
+		subscribers: make(map[chan []DeviceEvent]struct{}),
+	}
+}
+
+// Subscribe registers a new subscriber and starts the detection loop if it is not already running.
+// The first batch of events received lists all currently known devices as DeviceAdded.
+// The returned channel is closed if the subscriber falls too far behind in receiving events.
+// unsubscribe must be called once the subscriber is no longer interested in events.
+func (w *DeviceWatcher) Subscribe() (events <-chan []DeviceEvent, unsubscribe func()) {
+	ch := make(chan []DeviceEvent, 16)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.subscribers[ch] = struct{}{}
+	if w.detected {
+		// catch up the new subscriber with the current state:
+		initial := make([]DeviceEvent, 0, 10)
+		for _, descriptors := range w.known {
+			for _, descriptor := range descriptors {
+				initial = append(initial, DeviceEvent{Type: DeviceAdded, Descriptor: descriptor})
+			}
+		}
+		ch <- initial
+	}
+
+	if w.stop == nil {
+		w.stop = make(chan struct{})
+		go w.run(w.stop)
+	}
+
+	unsubscribe = func() {
+		w.lock.Lock()
+		defer w.lock.Unlock()
+
+		if _, ok := w.subscribers[ch]; !ok {
+			return
+		}
+		delete(w.subscribers, ch)
+		close(ch)
+
+		if len(w.subscribers) == 0 && w.stop != nil {
+			// stop detecting and forget state so the next subscriber starts fresh:
+			close(w.stop)
+			w.stop = nil
+			w.known = nil
+			w.detected = false
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+func (w *DeviceWatcher) run(stop chan struct{}) {
+	defer util.Recover()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.detect(stop)
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *DeviceWatcher) detect(stop chan struct{}) {
+	w.lock.Lock()
+	previous := w.known
+	w.lock.Unlock()
+
+	// detect outside the lock since drivers may take a while:
+	next := make(map[string][]DeviceDescriptor)
+	for _, named := range Drivers() {
+		descriptors, err := named.Driver.Detect()
+		if err != nil {
+			log.Printf("watcher: %s: detect: %v\n", named.Name, err)
+			// assume nothing changed for this driver:
+			descriptors = previous[named.Name]
+		}
+		next[named.Name] = descriptors
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	select {
+	case <-stop:
+		// unsubscribed during detection:
+		return
+	default:
+	}
+
+	events := diffDescriptors(w.known, next)
+	w.known = next
+	if w.detected && len(events) == 0 {
+		return
+	}
+	w.detected = true
+
+	for ch := range w.subscribers {
+		select {
+		case ch <- events:
+		default:
+			// subscriber is not keeping up; drop it:
+			delete(w.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func diffDescriptors(previous, next map[string][]DeviceDescriptor) (events []DeviceEvent) {
+	prevByUri := make(map[string]DeviceDescriptor)
+	for _, descriptors := range previous {
+		for _, descriptor := range descriptors {
+			prevByUri[descriptor.Uri.String()] = descriptor
+		}
+	}
+
+	events = make([]DeviceEvent, 0, len(prevByUri))
+	seen := make(map[string]struct{})
+	for _, named := range Drivers() {
+		for _, descriptor := range next[named.Name] {
+			uri := descriptor.Uri.String()
+			seen[uri] = struct{}{}
+
+			prev, ok := prevByUri[uri]
+			if !ok {
+				events = append(events, DeviceEvent{Type: DeviceAdded, Descriptor: descriptor})
+			} else if !descriptorsEqual(&prev, &descriptor) {
+				events = append(events, DeviceEvent{Type: DeviceChanged, Descriptor: descriptor})
+			}
+		}
+	}
+
+	for uri, descriptor := range prevByUri {
+		if _, ok := seen[uri]; ok {
+			continue
+		}
+		events = append(events, DeviceEvent{Type: DeviceRemoved, Descriptor: descriptor})
+	}
+
+	return
+}
+
+func descriptorsEqual(a, b *DeviceDescriptor) bool {
+	if a.DisplayName != b.DisplayName ||
+		a.Kind != b.Kind ||
+		a.DefaultAddressSpace != b.DefaultAddressSpace ||
+		a.System != b.System {
+		return false
+	}
+	if len(a.Capabilities) != len(b.Capabilities) {
+		return false
+	}
+	for i := range a.Capabilities {
+		if a.Capabilities[i] != b.Capabilities[i] {
+			return false
+		}
+	}
+	return true
+}
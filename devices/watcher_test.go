@@ -0,0 +1,212 @@
+package devices_test
+
+import (
+	"errors"
+	"net/url"
+	"sni/devices"
+	"sni/devices/snes/drivers/mock"
+	"sni/protos/sni"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// toggleDriver is the mock driver whose detected devices the test controls. Each device is the mock device
+// renamed to its opaque URI.
+type toggleDriver struct {
+	*mock.Driver
+
+	lock    sync.Mutex
+	present map[string][]sni.DeviceCapability
+	err     error
+}
+
+func (d *toggleDriver) set(opaque string, capabilities ...sni.DeviceCapability) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.present[opaque] = capabilities
+}
+
+func (d *toggleDriver) remove(opaque string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.present, opaque)
+}
+
+func (d *toggleDriver) fail(err error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.err = err
+}
+
+func (d *toggleDriver) Detect() (descriptors []devices.DeviceDescriptor, err error) {
+	mockDescriptors, err := d.Driver.Detect()
+	if err != nil {
+		return
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	for opaque, capabilities := range d.present {
+		descriptor := mockDescriptors[0]
+		descriptor.Uri = url.URL{Scheme: "mock", Opaque: opaque}
+		descriptor.Capabilities = capabilities
+		descriptors = append(descriptors, descriptor)
+	}
+	sort.Slice(descriptors, func(i, j int) bool { return descriptors[i].Uri.Opaque < descriptors[j].Uri.Opaque })
+	return
+}
+
+func registerToggleDriver(t *testing.T) *toggleDriver {
+	t.Helper()
+
+	d := &toggleDriver{Driver: &mock.Driver{}, present: make(map[string][]sni.DeviceCapability)}
+	devices.UnregisterAllDrivers()
+	devices.Register("mock", d)
+	t.Cleanup(devices.UnregisterAllDrivers)
+	return d
+}
+
+type watchEvent struct {
+	Type   devices.DeviceEventType
+	Opaque string
+}
+
+func receive(t *testing.T, events <-chan []devices.DeviceEvent) (got []watchEvent) {
+	t.Helper()
+
+	select {
+	case batch, ok := <-events:
+		if !ok {
+			t.Fatal("events closed")
+		}
+		for _, event := range batch {
+			got = append(got, watchEvent{event.Type, event.Descriptor.Uri.Opaque})
+		}
+		sort.Slice(got, func(i, j int) bool { return got[i].Opaque < got[j].Opaque })
+		return
+	case <-time.After(time.Second):
+		t.Fatal("no events received")
+		return
+	}
+}
+
+func assertEvents(t *testing.T, got []watchEvent, want ...watchEvent) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("events = %v, want %v", got, want)
+		}
+	}
+}
+
+func assertNoEvents(t *testing.T, events <-chan []devices.DeviceEvent) {
+	t.Helper()
+
+	select {
+	case batch := <-events:
+		t.Fatalf("unexpected events %v", batch)
+	default:
+	}
+}
+
+func TestDeviceWatcher_diff(t *testing.T) {
+	d := registerToggleDriver(t)
+	d.set("a", sni.DeviceCapability_ReadMemory)
+
+	w := devices.NewDeviceWatcher(time.Hour)
+	events, unsubscribe := w.Subscribe()
+	defer unsubscribe()
+
+	// the first detection lists every device as added:
+	assertEvents(t, receive(t, events), watchEvent{devices.DeviceAdded, "a"})
+
+	// a later subscriber is caught up with the known devices:
+	late, unsubscribeLate := w.Subscribe()
+	assertEvents(t, receive(t, late), watchEvent{devices.DeviceAdded, "a"})
+	unsubscribeLate()
+
+	// nothing changed so nothing is sent:
+	w.Detect()
+	assertNoEvents(t, events)
+
+	d.set("b", sni.DeviceCapability_ReadMemory)
+	d.set("a", sni.DeviceCapability_ReadMemory, sni.DeviceCapability_WriteMemory)
+	w.Detect()
+	assertEvents(t, receive(t, events),
+		watchEvent{devices.DeviceChanged, "a"},
+		watchEvent{devices.DeviceAdded, "b"},
+	)
+
+	// a failed detection assumes the driver's devices did not change:
+	d.fail(errors.New("detect failed"))
+	w.Detect()
+	assertNoEvents(t, events)
+	d.fail(nil)
+
+	d.remove("a")
+	w.Detect()
+	assertEvents(t, receive(t, events), watchEvent{devices.DeviceRemoved, "a"})
+}
+
+func TestDeviceWatcher_dropsSlowSubscriber(t *testing.T) {
+	d := registerToggleDriver(t)
+
+	w := devices.NewDeviceWatcher(time.Hour)
+	slow, unsubscribeSlow := w.Subscribe()
+	defer unsubscribeSlow()
+	events, unsubscribe := w.Subscribe()
+	defer unsubscribe()
+
+	// keep receiving on one subscriber only until the other's buffer overflows:
+	receive(t, events)
+	for i := 0; i < 20; i++ {
+		if i%2 == 0 {
+			d.set("a")
+		} else {
+			d.remove("a")
+		}
+		w.Detect()
+		receive(t, events)
+	}
+
+	// the slow subscriber receives what was buffered and is then closed:
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-slow:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("slow subscriber was not dropped")
+		}
+	}
+}
+
+func TestDeviceWatcher_unsubscribeStops(t *testing.T) {
+	d := registerToggleDriver(t)
+	d.set("a")
+
+	w := devices.NewDeviceWatcher(time.Hour)
+	events, unsubscribe := w.Subscribe()
+	receive(t, events)
+	unsubscribe()
+	if _, ok := <-events; ok {
+		t.Fatal("events not closed after unsubscribe")
+	}
+
+	// the next subscriber starts from a fresh detection:
+	events, unsubscribe = w.Subscribe()
+	defer unsubscribe()
+	assertEvents(t, receive(t, events), watchEvent{devices.DeviceAdded, "a"})
+}
@@ -3,6 +3,7 @@
 -- version 3 changes Read response from JSON to HEX
 -- lua 5.1/5.4 shim by zig; modifications licensed under MIT and WTFPL
 -- version 4 enhances the message processing loop to allow for more than one message per game frame
+-- version 5 adds SaveState, LoadState and Screenshot commands
 
 function get_lua_version()
     local major, minor = _VERSION:match("Lua (%d+)%.(%d+)")
@@ -148,9 +149,33 @@ local function onMessage(s)
         print(parts[2])
     elseif parts[1] == "Version" then
         if is_snes9x then
-            connection:send("Version|SNI Connector|4|Snes9x\n")
+            connection:send("Version|SNI Connector|5|Snes9x\n")
         else
-            connection:send("Version|SNI Connector|4|Bizhawk\n")
+            connection:send("Version|SNI Connector|5|Bizhawk\n")
+        end
+    elseif parts[1] == "SaveState" then
+        local slot = tonumber(parts[2])
+        if slot == nil then
+            print("Invalid state slot: " .. tostring(parts[2]))
+        else
+            print("Saving state to slot " .. slot .. "...")
+            if is_snes9x then
+                savestate.save(savestate.create(slot))
+            else
+                savestate.saveslot(slot)
+            end
+        end
+    elseif parts[1] == "LoadState" then
+        local slot = tonumber(parts[2])
+        if slot == nil then
+            print("Invalid state slot: " .. tostring(parts[2]))
+        else
+            print("Loading state from slot " .. slot .. "...")
+            if is_snes9x then
+                savestate.load(savestate.create(slot))
+            else
+                savestate.loadslot(slot)
+            end
         end
     elseif is_snes9x ~= true then
         if parts[1] == "Reset" then
@@ -165,6 +190,9 @@ local function onMessage(s)
         elseif parts[1] == "PauseToggle" then
             print("Toggling pause...")
             client.togglepause()
+        elseif parts[1] == "Screenshot" then
+            print("Taking screenshot...")
+            client.screenshot()
         end
     end
 end
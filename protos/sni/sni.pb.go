@@ -92,7 +92,8 @@ const (
 	MemoryMapping_HiROM   MemoryMapping = 1
 	MemoryMapping_LoROM   MemoryMapping = 2
 	MemoryMapping_ExHiROM MemoryMapping = 3 // (48-64Mbit)
-	MemoryMapping_SA1     MemoryMapping = 4 // TODO: BSX = 5;
+	MemoryMapping_SA1     MemoryMapping = 4
+	MemoryMapping_BSX     MemoryMapping = 5 // Satellaview BS-X cart with memory pack and PSRAM
 )
 
 // Enum value maps for MemoryMapping.
@@ -103,6 +104,7 @@ var (
 		2: "LoROM",
 		3: "ExHiROM",
 		4: "SA1",
+		5: "BSX",
 	}
 	MemoryMapping_value = map[string]int32{
 		"Unknown": 0,
@@ -110,6 +112,7 @@ var (
 		"LoROM":   2,
 		"ExHiROM": 3,
 		"SA1":     4,
+		"BSX":     5,
 	}
 )
 
@@ -161,6 +164,15 @@ const (
 	DeviceCapability_GetFile               DeviceCapability = 15
 	DeviceCapability_BootFile              DeviceCapability = 16
 	DeviceCapability_NWACommand            DeviceCapability = 20
+	DeviceCapability_PowerCycle            DeviceCapability = 30
+	DeviceCapability_SetRealTimeClock      DeviceCapability = 31
+	DeviceCapability_FrameAdvance          DeviceCapability = 32
+	DeviceCapability_SaveState             DeviceCapability = 40
+	DeviceCapability_LoadState             DeviceCapability = 41
+	DeviceCapability_Screenshot            DeviceCapability = 42
+	// SaveState and LoadState accept an explicit slot; the current slot is used otherwise:
+	DeviceCapability_SaveStateSlot DeviceCapability = 43
+	DeviceCapability_LoadStateSlot DeviceCapability = 44
 )
 
 // Enum value maps for DeviceCapability.
@@ -183,6 +195,14 @@ var (
 		15: "GetFile",
 		16: "BootFile",
 		20: "NWACommand",
+		30: "PowerCycle",
+		31: "SetRealTimeClock",
+		32: "FrameAdvance",
+		40: "SaveState",
+		41: "LoadState",
+		42: "Screenshot",
+		43: "SaveStateSlot",
+		44: "LoadStateSlot",
 	}
 	DeviceCapability_value = map[string]int32{
 		"None":                  0,
@@ -202,6 +222,14 @@ var (
 		"GetFile":               15,
 		"BootFile":              16,
 		"NWACommand":            20,
+		"PowerCycle":            30,
+		"SetRealTimeClock":      31,
+		"FrameAdvance":          32,
+		"SaveState":             40,
+		"LoadState":             41,
+		"Screenshot":            42,
+		"SaveStateSlot":         43,
+		"LoadStateSlot":         44,
 	}
 )
 
@@ -239,12 +267,16 @@ const (
 	Field_DeviceName    Field = 0
 	Field_DeviceVersion Field = 1
 	Field_DeviceStatus  Field = 2
-	Field_CoreName      Field = 20
-	Field_CoreVersion   Field = 21
-	Field_CorePlatform  Field = 22
-	Field_RomFileName   Field = 40
-	Field_RomHashType   Field = 41
-	Field_RomHashValue  Field = 42
+	// comma-delimited features supported by the device firmware, e.g. "CMD_UNLOCK,USB1,DMA1" on the FX Pak Pro:
+	Field_DeviceFeatures Field = 3
+	Field_CoreName       Field = 20
+	Field_CoreVersion    Field = 21
+	Field_CorePlatform   Field = 22
+	Field_RomFileName    Field = 40
+	Field_RomHashType    Field = 41
+	Field_RomHashValue   Field = 42
+	// comma-delimited cartridge features enabled for the loaded ROM, e.g. "SRTC,MSU1" on the FX Pak Pro:
+	Field_CartridgeFeatures Field = 43
 )
 
 // Enum value maps for Field.
@@ -253,23 +285,27 @@ var (
 		0:  "DeviceName",
 		1:  "DeviceVersion",
 		2:  "DeviceStatus",
+		3:  "DeviceFeatures",
 		20: "CoreName",
 		21: "CoreVersion",
 		22: "CorePlatform",
 		40: "RomFileName",
 		41: "RomHashType",
 		42: "RomHashValue",
+		43: "CartridgeFeatures",
 	}
 	Field_value = map[string]int32{
-		"DeviceName":    0,
-		"DeviceVersion": 1,
-		"DeviceStatus":  2,
-		"CoreName":      20,
-		"CoreVersion":   21,
-		"CorePlatform":  22,
-		"RomFileName":   40,
-		"RomHashType":   41,
-		"RomHashValue":  42,
+		"DeviceName":        0,
+		"DeviceVersion":     1,
+		"DeviceStatus":      2,
+		"DeviceFeatures":    3,
+		"CoreName":          20,
+		"CoreVersion":       21,
+		"CorePlatform":      22,
+		"RomFileName":       40,
+		"RomHashType":       41,
+		"RomHashValue":      42,
+		"CartridgeFeatures": 43,
 	}
 )
 
@@ -346,13 +382,67 @@ func (DirEntryType) EnumDescriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{4}
 }
 
+type WatchDevicesResponse_EventType int32
+
+const (
+	// device was newly detected; the first message of the stream lists all currently detected devices as Added
+	WatchDevicesResponse_Added WatchDevicesResponse_EventType = 0
+	// device is no longer detected
+	WatchDevicesResponse_Removed WatchDevicesResponse_EventType = 1
+	// device is still detected but its details (e.g. displayName or capabilities) changed
+	WatchDevicesResponse_Changed WatchDevicesResponse_EventType = 2
+)
+
+// Enum value maps for WatchDevicesResponse_EventType.
+var (
+	WatchDevicesResponse_EventType_name = map[int32]string{
+		0: "Added",
+		1: "Removed",
+		2: "Changed",
+	}
+	WatchDevicesResponse_EventType_value = map[string]int32{
+		"Added":   0,
+		"Removed": 1,
+		"Changed": 2,
+	}
+)
+
+func (x WatchDevicesResponse_EventType) Enum() *WatchDevicesResponse_EventType {
+	p := new(WatchDevicesResponse_EventType)
+	*p = x
+	return p
+}
+
+func (x WatchDevicesResponse_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchDevicesResponse_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[5].Descriptor()
+}
+
+func (WatchDevicesResponse_EventType) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[5]
+}
+
+func (x WatchDevicesResponse_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchDevicesResponse_EventType.Descriptor instead.
+func (WatchDevicesResponse_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{7, 0}
+}
+
 type DevicesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// optional list of device kind filters
-	Kinds []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"` // TODO: repeated DeviceCapability capabilities;
+	Kinds []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// optional list of capabilities that devices must all support
+	Capabilities []DeviceCapability `protobuf:"varint,2,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
 }
 
 func (x *DevicesRequest) Reset() {
@@ -394,6 +484,13 @@ func (x *DevicesRequest) GetKinds() []string {
 	return nil
 }
 
+func (x *DevicesRequest) GetCapabilities() []DeviceCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
 type DevicesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -441,16 +538,22 @@ func (x *DevicesResponse) GetDevices() []*DevicesResponse_Device {
 	return nil
 }
 
-type ResetSystemRequest struct {
+type AcquireLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// lease duration in nanoseconds; defaults to 5 seconds if 0
+	TtlNanoseconds uint64 `protobuf:"varint,2,opt,name=ttlNanoseconds,proto3" json:"ttlNanoseconds,omitempty"`
+	// wait for another client's lease to be released or expire instead of failing with ABORTED
+	Wait bool `protobuf:"varint,3,opt,name=wait,proto3" json:"wait,omitempty"`
+	// the currently held lease to renew, if any
+	LeaseId string `protobuf:"bytes,4,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
 }
 
-func (x *ResetSystemRequest) Reset() {
-	*x = ResetSystemRequest{}
+func (x *AcquireLeaseRequest) Reset() {
+	*x = AcquireLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -458,13 +561,13 @@ func (x *ResetSystemRequest) Reset() {
 	}
 }
 
-func (x *ResetSystemRequest) String() string {
+func (x *AcquireLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSystemRequest) ProtoMessage() {}
+func (*AcquireLeaseRequest) ProtoMessage() {}
 
-func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
+func (x *AcquireLeaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -476,28 +579,52 @@ func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSystemRequest.ProtoReflect.Descriptor instead.
-func (*ResetSystemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcquireLeaseRequest.ProtoReflect.Descriptor instead.
+func (*AcquireLeaseRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ResetSystemRequest) GetUri() string {
+func (x *AcquireLeaseRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type ResetSystemResponse struct {
+func (x *AcquireLeaseRequest) GetTtlNanoseconds() uint64 {
+	if x != nil {
+		return x.TtlNanoseconds
+	}
+	return 0
+}
+
+func (x *AcquireLeaseRequest) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *AcquireLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+type AcquireLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Uri     string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	LeaseId string `protobuf:"bytes,2,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	// time the lease expires at, in nanoseconds since the unix epoch
+	ExpiresAtUnixNanoseconds int64 `protobuf:"varint,3,opt,name=expiresAtUnixNanoseconds,proto3" json:"expiresAtUnixNanoseconds,omitempty"`
 }
 
-func (x *ResetSystemResponse) Reset() {
-	*x = ResetSystemResponse{}
+func (x *AcquireLeaseResponse) Reset() {
+	*x = AcquireLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,13 +632,13 @@ func (x *ResetSystemResponse) Reset() {
 	}
 }
 
-func (x *ResetSystemResponse) String() string {
+func (x *AcquireLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSystemResponse) ProtoMessage() {}
+func (*AcquireLeaseResponse) ProtoMessage() {}
 
-func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
+func (x *AcquireLeaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,28 +650,43 @@ func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSystemResponse.ProtoReflect.Descriptor instead.
-func (*ResetSystemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcquireLeaseResponse.ProtoReflect.Descriptor instead.
+func (*AcquireLeaseResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ResetSystemResponse) GetUri() string {
+func (x *AcquireLeaseResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type ResetToMenuRequest struct {
+func (x *AcquireLeaseResponse) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *AcquireLeaseResponse) GetExpiresAtUnixNanoseconds() int64 {
+	if x != nil {
+		return x.ExpiresAtUnixNanoseconds
+	}
+	return 0
+}
+
+type ReleaseLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Uri     string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	LeaseId string `protobuf:"bytes,2,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
 }
 
-func (x *ResetToMenuRequest) Reset() {
-	*x = ResetToMenuRequest{}
+func (x *ReleaseLeaseRequest) Reset() {
+	*x = ReleaseLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -552,13 +694,13 @@ func (x *ResetToMenuRequest) Reset() {
 	}
 }
 
-func (x *ResetToMenuRequest) String() string {
+func (x *ReleaseLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetToMenuRequest) ProtoMessage() {}
+func (*ReleaseLeaseRequest) ProtoMessage() {}
 
-func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
+func (x *ReleaseLeaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -570,19 +712,26 @@ func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetToMenuRequest.ProtoReflect.Descriptor instead.
-func (*ResetToMenuRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ResetToMenuRequest) GetUri() string {
+func (x *ReleaseLeaseRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type ResetToMenuResponse struct {
+func (x *ReleaseLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+type ReleaseLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -590,8 +739,8 @@ type ResetToMenuResponse struct {
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *ResetToMenuResponse) Reset() {
-	*x = ResetToMenuResponse{}
+func (x *ReleaseLeaseResponse) Reset() {
+	*x = ReleaseLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -599,13 +748,13 @@ func (x *ResetToMenuResponse) Reset() {
 	}
 }
 
-func (x *ResetToMenuResponse) String() string {
+func (x *ReleaseLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetToMenuResponse) ProtoMessage() {}
+func (*ReleaseLeaseResponse) ProtoMessage() {}
 
-func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
+func (x *ReleaseLeaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,30 +766,31 @@ func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetToMenuResponse.ProtoReflect.Descriptor instead.
-func (*ResetToMenuResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ResetToMenuResponse) GetUri() string {
+func (x *ReleaseLeaseResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type PauseEmulationRequest struct {
+type WatchDevicesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// true to pause emulation, false to unpause
-	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// optional list of device kind filters
+	Kinds []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// optional list of capabilities that devices must all support
+	Capabilities []DeviceCapability `protobuf:"varint,2,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
 }
 
-func (x *PauseEmulationRequest) Reset() {
-	*x = PauseEmulationRequest{}
+func (x *WatchDevicesRequest) Reset() {
+	*x = WatchDevicesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -648,13 +798,13 @@ func (x *PauseEmulationRequest) Reset() {
 	}
 }
 
-func (x *PauseEmulationRequest) String() string {
+func (x *WatchDevicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseEmulationRequest) ProtoMessage() {}
+func (*WatchDevicesRequest) ProtoMessage() {}
 
-func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchDevicesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,36 +816,35 @@ func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseEmulationRequest.ProtoReflect.Descriptor instead.
-func (*PauseEmulationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchDevicesRequest.ProtoReflect.Descriptor instead.
+func (*WatchDevicesRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PauseEmulationRequest) GetUri() string {
+func (x *WatchDevicesRequest) GetKinds() []string {
 	if x != nil {
-		return x.Uri
+		return x.Kinds
 	}
-	return ""
+	return nil
 }
 
-func (x *PauseEmulationRequest) GetPaused() bool {
+func (x *WatchDevicesRequest) GetCapabilities() []DeviceCapability {
 	if x != nil {
-		return x.Paused
+		return x.Capabilities
 	}
-	return false
+	return nil
 }
 
-type PauseEmulationResponse struct {
+type WatchDevicesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri    string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Events []*WatchDevicesResponse_Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *PauseEmulationResponse) Reset() {
-	*x = PauseEmulationResponse{}
+func (x *WatchDevicesResponse) Reset() {
+	*x = WatchDevicesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -703,13 +852,13 @@ func (x *PauseEmulationResponse) Reset() {
 	}
 }
 
-func (x *PauseEmulationResponse) String() string {
+func (x *WatchDevicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseEmulationResponse) ProtoMessage() {}
+func (*WatchDevicesResponse) ProtoMessage() {}
 
-func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchDevicesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,26 +870,19 @@ func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseEmulationResponse.ProtoReflect.Descriptor instead.
-func (*PauseEmulationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchDevicesResponse.ProtoReflect.Descriptor instead.
+func (*WatchDevicesResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PauseEmulationResponse) GetUri() string {
-	if x != nil {
-		return x.Uri
-	}
-	return ""
-}
-
-func (x *PauseEmulationResponse) GetPaused() bool {
+func (x *WatchDevicesResponse) GetEvents() []*WatchDevicesResponse_Event {
 	if x != nil {
-		return x.Paused
+		return x.Events
 	}
-	return false
+	return nil
 }
 
-type PauseToggleEmulationRequest struct {
+type ResetSystemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -748,8 +890,8 @@ type PauseToggleEmulationRequest struct {
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *PauseToggleEmulationRequest) Reset() {
-	*x = PauseToggleEmulationRequest{}
+func (x *ResetSystemRequest) Reset() {
+	*x = ResetSystemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -757,13 +899,13 @@ func (x *PauseToggleEmulationRequest) Reset() {
 	}
 }
 
-func (x *PauseToggleEmulationRequest) String() string {
+func (x *ResetSystemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseToggleEmulationRequest) ProtoMessage() {}
+func (*ResetSystemRequest) ProtoMessage() {}
 
-func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -775,19 +917,19 @@ func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseToggleEmulationRequest.ProtoReflect.Descriptor instead.
-func (*PauseToggleEmulationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetSystemRequest.ProtoReflect.Descriptor instead.
+func (*ResetSystemRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PauseToggleEmulationRequest) GetUri() string {
+func (x *ResetSystemRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type PauseToggleEmulationResponse struct {
+type ResetSystemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -795,8 +937,8 @@ type PauseToggleEmulationResponse struct {
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *PauseToggleEmulationResponse) Reset() {
-	*x = PauseToggleEmulationResponse{}
+func (x *ResetSystemResponse) Reset() {
+	*x = ResetSystemResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -804,13 +946,13 @@ func (x *PauseToggleEmulationResponse) Reset() {
 	}
 }
 
-func (x *PauseToggleEmulationResponse) String() string {
+func (x *ResetSystemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseToggleEmulationResponse) ProtoMessage() {}
+func (*ResetSystemResponse) ProtoMessage() {}
 
-func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
+func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -822,33 +964,28 @@ func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseToggleEmulationResponse.ProtoReflect.Descriptor instead.
-func (*PauseToggleEmulationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetSystemResponse.ProtoReflect.Descriptor instead.
+func (*ResetSystemResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PauseToggleEmulationResponse) GetUri() string {
+func (x *ResetSystemResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type DetectMemoryMappingRequest struct {
+type ResetToMenuRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// optional fallback value to set in case detection fails for some reason
-	FallbackMemoryMapping *MemoryMapping `protobuf:"varint,2,opt,name=fallbackMemoryMapping,proto3,enum=MemoryMapping,oneof" json:"fallbackMemoryMapping,omitempty"`
-	// optional ROM header (from bus address $00:FFB0, at least $30 bytes long) to use for detection
-	// if not provided, the header will be read from the device
-	RomHeader00FFB0 []byte `protobuf:"bytes,3,opt,name=romHeader00FFB0,proto3,oneof" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *DetectMemoryMappingRequest) Reset() {
-	*x = DetectMemoryMappingRequest{}
+func (x *ResetToMenuRequest) Reset() {
+	*x = ResetToMenuRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,13 +993,13 @@ func (x *DetectMemoryMappingRequest) Reset() {
 	}
 }
 
-func (x *DetectMemoryMappingRequest) String() string {
+func (x *ResetToMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetectMemoryMappingRequest) ProtoMessage() {}
+func (*ResetToMenuRequest) ProtoMessage() {}
 
-func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,48 +1011,28 @@ func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetectMemoryMappingRequest.ProtoReflect.Descriptor instead.
-func (*DetectMemoryMappingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetToMenuRequest.ProtoReflect.Descriptor instead.
+func (*ResetToMenuRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DetectMemoryMappingRequest) GetUri() string {
+func (x *ResetToMenuRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *DetectMemoryMappingRequest) GetFallbackMemoryMapping() MemoryMapping {
-	if x != nil && x.FallbackMemoryMapping != nil {
-		return *x.FallbackMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *DetectMemoryMappingRequest) GetRomHeader00FFB0() []byte {
-	if x != nil {
-		return x.RomHeader00FFB0
-	}
-	return nil
-}
-
-type DetectMemoryMappingResponse struct {
+type ResetToMenuResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// the memory mapping mode detected
-	MemoryMapping MemoryMapping `protobuf:"varint,2,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
-	// true if confident we detected a mapping; false if using a fallback or default value
-	Confidence bool `protobuf:"varint,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
-	// the ROM header read from $00:FFB0, length is $50 bytes if server reads it, otherwise length of `romHeader00FFB0` from request
-	RomHeader00FFB0 []byte `protobuf:"bytes,4,opt,name=romHeader00FFB0,proto3" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *DetectMemoryMappingResponse) Reset() {
-	*x = DetectMemoryMappingResponse{}
+func (x *ResetToMenuResponse) Reset() {
+	*x = ResetToMenuResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -923,13 +1040,13 @@ func (x *DetectMemoryMappingResponse) Reset() {
 	}
 }
 
-func (x *DetectMemoryMappingResponse) String() string {
+func (x *ResetToMenuResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetectMemoryMappingResponse) ProtoMessage() {}
+func (*ResetToMenuResponse) ProtoMessage() {}
 
-func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
+func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -941,52 +1058,30 @@ func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetectMemoryMappingResponse.ProtoReflect.Descriptor instead.
-func (*DetectMemoryMappingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetToMenuResponse.ProtoReflect.Descriptor instead.
+func (*ResetToMenuResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DetectMemoryMappingResponse) GetUri() string {
+func (x *ResetToMenuResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *DetectMemoryMappingResponse) GetMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.MemoryMapping
-	}
-	return MemoryMapping_Unknown
+type PauseEmulationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// true to pause emulation, false to unpause
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (x *DetectMemoryMappingResponse) GetConfidence() bool {
-	if x != nil {
-		return x.Confidence
-	}
-	return false
-}
-
-func (x *DetectMemoryMappingResponse) GetRomHeader00FFB0() []byte {
-	if x != nil {
-		return x.RomHeader00FFB0
-	}
-	return nil
-}
-
-type ReadMemoryRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	Size                 uint32        `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-}
-
-func (x *ReadMemoryRequest) Reset() {
-	*x = ReadMemoryRequest{}
+func (x *PauseEmulationRequest) Reset() {
+	*x = PauseEmulationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -994,13 +1089,13 @@ func (x *ReadMemoryRequest) Reset() {
 	}
 }
 
-func (x *ReadMemoryRequest) String() string {
+func (x *PauseEmulationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMemoryRequest) ProtoMessage() {}
+func (*PauseEmulationRequest) ProtoMessage() {}
 
-func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1012,55 +1107,36 @@ func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMemoryRequest.ProtoReflect.Descriptor instead.
-func (*ReadMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseEmulationRequest.ProtoReflect.Descriptor instead.
+func (*PauseEmulationRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ReadMemoryRequest) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *ReadMemoryRequest) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *ReadMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+func (x *PauseEmulationRequest) GetUri() string {
 	if x != nil {
-		return x.RequestMemoryMapping
+		return x.Uri
 	}
-	return MemoryMapping_Unknown
+	return ""
 }
 
-func (x *ReadMemoryRequest) GetSize() uint32 {
+func (x *PauseEmulationRequest) GetPaused() bool {
 	if x != nil {
-		return x.Size
+		return x.Paused
 	}
-	return 0
+	return false
 }
 
-type ReadMemoryResponse struct {
+type PauseEmulationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	// the address sent to the device and its space
-	DeviceAddress      uint32       `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
-	DeviceAddressSpace AddressSpace `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
-	Data               []byte       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Uri    string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (x *ReadMemoryResponse) Reset() {
-	*x = ReadMemoryResponse{}
+func (x *PauseEmulationResponse) Reset() {
+	*x = PauseEmulationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1068,13 +1144,13 @@ func (x *ReadMemoryResponse) Reset() {
 	}
 }
 
-func (x *ReadMemoryResponse) String() string {
+func (x *PauseEmulationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMemoryResponse) ProtoMessage() {}
+func (*PauseEmulationResponse) ProtoMessage() {}
 
-func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1086,66 +1162,35 @@ func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMemoryResponse.ProtoReflect.Descriptor instead.
-func (*ReadMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseEmulationResponse.ProtoReflect.Descriptor instead.
+func (*PauseEmulationResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ReadMemoryResponse) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *ReadMemoryResponse) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *ReadMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.RequestMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *ReadMemoryResponse) GetDeviceAddress() uint32 {
-	if x != nil {
-		return x.DeviceAddress
-	}
-	return 0
-}
-
-func (x *ReadMemoryResponse) GetDeviceAddressSpace() AddressSpace {
+func (x *PauseEmulationResponse) GetUri() string {
 	if x != nil {
-		return x.DeviceAddressSpace
+		return x.Uri
 	}
-	return AddressSpace_FxPakPro
+	return ""
 }
 
-func (x *ReadMemoryResponse) GetData() []byte {
+func (x *PauseEmulationResponse) GetPaused() bool {
 	if x != nil {
-		return x.Data
+		return x.Paused
 	}
-	return nil
+	return false
 }
 
-type WriteMemoryRequest struct {
+type PauseToggleEmulationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	Data                 []byte        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *WriteMemoryRequest) Reset() {
-	*x = WriteMemoryRequest{}
+func (x *PauseToggleEmulationRequest) Reset() {
+	*x = PauseToggleEmulationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1153,13 +1198,13 @@ func (x *WriteMemoryRequest) Reset() {
 	}
 }
 
-func (x *WriteMemoryRequest) String() string {
+func (x *PauseToggleEmulationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteMemoryRequest) ProtoMessage() {}
+func (*PauseToggleEmulationRequest) ProtoMessage() {}
 
-func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1171,54 +1216,28 @@ func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteMemoryRequest.ProtoReflect.Descriptor instead.
-func (*WriteMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseToggleEmulationRequest.ProtoReflect.Descriptor instead.
+func (*PauseToggleEmulationRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *WriteMemoryRequest) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *WriteMemoryRequest) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *WriteMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.RequestMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *WriteMemoryRequest) GetData() []byte {
+func (x *PauseToggleEmulationRequest) GetUri() string {
 	if x != nil {
-		return x.Data
+		return x.Uri
 	}
-	return nil
+	return ""
 }
 
-type WriteMemoryResponse struct {
+type PauseToggleEmulationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	DeviceAddress        uint32        `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
-	DeviceAddressSpace   AddressSpace  `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
-	Size                 uint32        `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *WriteMemoryResponse) Reset() {
-	*x = WriteMemoryResponse{}
+func (x *PauseToggleEmulationResponse) Reset() {
+	*x = PauseToggleEmulationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1226,13 +1245,13 @@ func (x *WriteMemoryResponse) Reset() {
 	}
 }
 
-func (x *WriteMemoryResponse) String() string {
+func (x *PauseToggleEmulationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteMemoryResponse) ProtoMessage() {}
+func (*PauseToggleEmulationResponse) ProtoMessage() {}
 
-func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1244,64 +1263,28 @@ func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteMemoryResponse.ProtoReflect.Descriptor instead.
-func (*WriteMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseToggleEmulationResponse.ProtoReflect.Descriptor instead.
+func (*PauseToggleEmulationResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *WriteMemoryResponse) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *WriteMemoryResponse) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *WriteMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.RequestMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *WriteMemoryResponse) GetDeviceAddress() uint32 {
-	if x != nil {
-		return x.DeviceAddress
-	}
-	return 0
-}
-
-func (x *WriteMemoryResponse) GetDeviceAddressSpace() AddressSpace {
-	if x != nil {
-		return x.DeviceAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *WriteMemoryResponse) GetSize() uint32 {
+func (x *PauseToggleEmulationResponse) GetUri() string {
 	if x != nil {
-		return x.Size
+		return x.Uri
 	}
-	return 0
+	return ""
 }
 
-type SingleReadMemoryRequest struct {
+type PowerCycleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Request *ReadMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *SingleReadMemoryRequest) Reset() {
-	*x = SingleReadMemoryRequest{}
+func (x *PowerCycleRequest) Reset() {
+	*x = PowerCycleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1309,13 +1292,13 @@ func (x *SingleReadMemoryRequest) Reset() {
 	}
 }
 
-func (x *SingleReadMemoryRequest) String() string {
+func (x *PowerCycleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleReadMemoryRequest) ProtoMessage() {}
+func (*PowerCycleRequest) ProtoMessage() {}
 
-func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *PowerCycleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1327,36 +1310,28 @@ func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleReadMemoryRequest.ProtoReflect.Descriptor instead.
-func (*SingleReadMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PowerCycleRequest.ProtoReflect.Descriptor instead.
+func (*PowerCycleRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SingleReadMemoryRequest) GetUri() string {
+func (x *PowerCycleRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleReadMemoryRequest) GetRequest() *ReadMemoryRequest {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-type SingleReadMemoryResponse struct {
+type PowerCycleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Response *ReadMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *SingleReadMemoryResponse) Reset() {
-	*x = SingleReadMemoryResponse{}
+func (x *PowerCycleResponse) Reset() {
+	*x = PowerCycleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1364,13 +1339,13 @@ func (x *SingleReadMemoryResponse) Reset() {
 	}
 }
 
-func (x *SingleReadMemoryResponse) String() string {
+func (x *PowerCycleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleReadMemoryResponse) ProtoMessage() {}
+func (*PowerCycleResponse) ProtoMessage() {}
 
-func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *PowerCycleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1382,36 +1357,31 @@ func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleReadMemoryResponse.ProtoReflect.Descriptor instead.
-func (*SingleReadMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PowerCycleResponse.ProtoReflect.Descriptor instead.
+func (*PowerCycleResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SingleReadMemoryResponse) GetUri() string {
+func (x *PowerCycleResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleReadMemoryResponse) GetResponse() *ReadMemoryResponse {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-type SingleWriteMemoryRequest struct {
+type SetRealTimeClockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Request *WriteMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// time to set the clock to, in seconds since the unix epoch; defaults to the current time.
+	// the clock is set to the local time of the SNI host since the console's clock has no time zone.
+	UnixSeconds *int64 `protobuf:"varint,2,opt,name=unixSeconds,proto3,oneof" json:"unixSeconds,omitempty"`
 }
 
-func (x *SingleWriteMemoryRequest) Reset() {
-	*x = SingleWriteMemoryRequest{}
+func (x *SetRealTimeClockRequest) Reset() {
+	*x = SetRealTimeClockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1419,13 +1389,13 @@ func (x *SingleWriteMemoryRequest) Reset() {
 	}
 }
 
-func (x *SingleWriteMemoryRequest) String() string {
+func (x *SetRealTimeClockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleWriteMemoryRequest) ProtoMessage() {}
+func (*SetRealTimeClockRequest) ProtoMessage() {}
 
-func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *SetRealTimeClockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1437,36 +1407,37 @@ func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleWriteMemoryRequest.ProtoReflect.Descriptor instead.
-func (*SingleWriteMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetRealTimeClockRequest.ProtoReflect.Descriptor instead.
+func (*SetRealTimeClockRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SingleWriteMemoryRequest) GetUri() string {
+func (x *SetRealTimeClockRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleWriteMemoryRequest) GetRequest() *WriteMemoryRequest {
-	if x != nil {
-		return x.Request
+func (x *SetRealTimeClockRequest) GetUnixSeconds() int64 {
+	if x != nil && x.UnixSeconds != nil {
+		return *x.UnixSeconds
 	}
-	return nil
+	return 0
 }
 
-type SingleWriteMemoryResponse struct {
+type SetRealTimeClockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Response *WriteMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// time the clock was set to, in seconds since the unix epoch
+	UnixSeconds int64 `protobuf:"varint,2,opt,name=unixSeconds,proto3" json:"unixSeconds,omitempty"`
 }
 
-func (x *SingleWriteMemoryResponse) Reset() {
-	*x = SingleWriteMemoryResponse{}
+func (x *SetRealTimeClockResponse) Reset() {
+	*x = SetRealTimeClockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1474,13 +1445,13 @@ func (x *SingleWriteMemoryResponse) Reset() {
 	}
 }
 
-func (x *SingleWriteMemoryResponse) String() string {
+func (x *SetRealTimeClockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleWriteMemoryResponse) ProtoMessage() {}
+func (*SetRealTimeClockResponse) ProtoMessage() {}
 
-func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *SetRealTimeClockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1492,36 +1463,37 @@ func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleWriteMemoryResponse.ProtoReflect.Descriptor instead.
-func (*SingleWriteMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetRealTimeClockResponse.ProtoReflect.Descriptor instead.
+func (*SetRealTimeClockResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SingleWriteMemoryResponse) GetUri() string {
+func (x *SetRealTimeClockResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleWriteMemoryResponse) GetResponse() *WriteMemoryResponse {
+func (x *SetRealTimeClockResponse) GetUnixSeconds() int64 {
 	if x != nil {
-		return x.Response
+		return x.UnixSeconds
 	}
-	return nil
+	return 0
 }
 
-type MultiReadMemoryRequest struct {
+type FrameAdvanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Requests []*ReadMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// number of frames to run; defaults to 1 if 0. emulation is paused first if it is running.
+	Frames uint32 `protobuf:"varint,2,opt,name=frames,proto3" json:"frames,omitempty"`
 }
 
-func (x *MultiReadMemoryRequest) Reset() {
-	*x = MultiReadMemoryRequest{}
+func (x *FrameAdvanceRequest) Reset() {
+	*x = FrameAdvanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1529,13 +1501,13 @@ func (x *MultiReadMemoryRequest) Reset() {
 	}
 }
 
-func (x *MultiReadMemoryRequest) String() string {
+func (x *FrameAdvanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiReadMemoryRequest) ProtoMessage() {}
+func (*FrameAdvanceRequest) ProtoMessage() {}
 
-func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *FrameAdvanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1547,36 +1519,35 @@ func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiReadMemoryRequest.ProtoReflect.Descriptor instead.
-func (*MultiReadMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrameAdvanceRequest.ProtoReflect.Descriptor instead.
+func (*FrameAdvanceRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MultiReadMemoryRequest) GetUri() string {
+func (x *FrameAdvanceRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiReadMemoryRequest) GetRequests() []*ReadMemoryRequest {
+func (x *FrameAdvanceRequest) GetFrames() uint32 {
 	if x != nil {
-		return x.Requests
+		return x.Frames
 	}
-	return nil
+	return 0
 }
 
-type MultiReadMemoryResponse struct {
+type FrameAdvanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri       string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Responses []*ReadMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *MultiReadMemoryResponse) Reset() {
-	*x = MultiReadMemoryResponse{}
+func (x *FrameAdvanceResponse) Reset() {
+	*x = FrameAdvanceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1584,13 +1555,13 @@ func (x *MultiReadMemoryResponse) Reset() {
 	}
 }
 
-func (x *MultiReadMemoryResponse) String() string {
+func (x *FrameAdvanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiReadMemoryResponse) ProtoMessage() {}
+func (*FrameAdvanceResponse) ProtoMessage() {}
 
-func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *FrameAdvanceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1602,36 +1573,33 @@ func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiReadMemoryResponse.ProtoReflect.Descriptor instead.
-func (*MultiReadMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrameAdvanceResponse.ProtoReflect.Descriptor instead.
+func (*FrameAdvanceResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *MultiReadMemoryResponse) GetUri() string {
+func (x *FrameAdvanceResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiReadMemoryResponse) GetResponses() []*ReadMemoryResponse {
-	if x != nil {
-		return x.Responses
-	}
-	return nil
-}
-
-type MultiWriteMemoryRequest struct {
+type DetectMemoryMappingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Requests []*WriteMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// optional fallback value to set in case detection fails for some reason
+	FallbackMemoryMapping *MemoryMapping `protobuf:"varint,2,opt,name=fallbackMemoryMapping,proto3,enum=MemoryMapping,oneof" json:"fallbackMemoryMapping,omitempty"`
+	// optional ROM header (from bus address $00:FFB0, at least $30 bytes long) to use for detection
+	// if not provided, the header will be read from the device
+	RomHeader00FFB0 []byte `protobuf:"bytes,3,opt,name=romHeader00FFB0,proto3,oneof" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *MultiWriteMemoryRequest) Reset() {
-	*x = MultiWriteMemoryRequest{}
+func (x *DetectMemoryMappingRequest) Reset() {
+	*x = DetectMemoryMappingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1639,13 +1607,13 @@ func (x *MultiWriteMemoryRequest) Reset() {
 	}
 }
 
-func (x *MultiWriteMemoryRequest) String() string {
+func (x *DetectMemoryMappingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiWriteMemoryRequest) ProtoMessage() {}
+func (*DetectMemoryMappingRequest) ProtoMessage() {}
 
-func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1657,36 +1625,48 @@ func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiWriteMemoryRequest.ProtoReflect.Descriptor instead.
-func (*MultiWriteMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DetectMemoryMappingRequest.ProtoReflect.Descriptor instead.
+func (*DetectMemoryMappingRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MultiWriteMemoryRequest) GetUri() string {
+func (x *DetectMemoryMappingRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiWriteMemoryRequest) GetRequests() []*WriteMemoryRequest {
+func (x *DetectMemoryMappingRequest) GetFallbackMemoryMapping() MemoryMapping {
+	if x != nil && x.FallbackMemoryMapping != nil {
+		return *x.FallbackMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *DetectMemoryMappingRequest) GetRomHeader00FFB0() []byte {
 	if x != nil {
-		return x.Requests
+		return x.RomHeader00FFB0
 	}
 	return nil
 }
 
-type MultiWriteMemoryResponse struct {
+type DetectMemoryMappingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri       string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Responses []*WriteMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// the memory mapping mode detected
+	MemoryMapping MemoryMapping `protobuf:"varint,2,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+	// true if confident we detected a mapping; false if using a fallback or default value
+	Confidence bool `protobuf:"varint,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// the ROM header read from $00:FFB0, length is $50 bytes if server reads it, otherwise length of `romHeader00FFB0` from request
+	RomHeader00FFB0 []byte `protobuf:"bytes,4,opt,name=romHeader00FFB0,proto3" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *MultiWriteMemoryResponse) Reset() {
-	*x = MultiWriteMemoryResponse{}
+func (x *DetectMemoryMappingResponse) Reset() {
+	*x = DetectMemoryMappingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1694,13 +1674,13 @@ func (x *MultiWriteMemoryResponse) Reset() {
 	}
 }
 
-func (x *MultiWriteMemoryResponse) String() string {
+func (x *DetectMemoryMappingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiWriteMemoryResponse) ProtoMessage() {}
+func (*DetectMemoryMappingResponse) ProtoMessage() {}
 
-func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1712,36 +1692,52 @@ func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiWriteMemoryResponse.ProtoReflect.Descriptor instead.
-func (*MultiWriteMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DetectMemoryMappingResponse.ProtoReflect.Descriptor instead.
+func (*DetectMemoryMappingResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MultiWriteMemoryResponse) GetUri() string {
+func (x *DetectMemoryMappingResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiWriteMemoryResponse) GetResponses() []*WriteMemoryResponse {
+func (x *DetectMemoryMappingResponse) GetMemoryMapping() MemoryMapping {
 	if x != nil {
-		return x.Responses
+		return x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *DetectMemoryMappingResponse) GetConfidence() bool {
+	if x != nil {
+		return x.Confidence
+	}
+	return false
+}
+
+func (x *DetectMemoryMappingResponse) GetRomHeader00FFB0() []byte {
+	if x != nil {
+		return x.RomHeader00FFB0
 	}
 	return nil
 }
 
-type ReadDirectoryRequest struct {
+type ReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	Size                 uint32        `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *ReadDirectoryRequest) Reset() {
-	*x = ReadDirectoryRequest{}
+func (x *ReadMemoryRequest) Reset() {
+	*x = ReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1749,13 +1745,13 @@ func (x *ReadDirectoryRequest) Reset() {
 	}
 }
 
-func (x *ReadDirectoryRequest) String() string {
+func (x *ReadMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirectoryRequest) ProtoMessage() {}
+func (*ReadMemoryRequest) ProtoMessage() {}
 
-func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1767,36 +1763,55 @@ func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirectoryRequest.ProtoReflect.Descriptor instead.
-func (*ReadDirectoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadMemoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ReadDirectoryRequest) GetUri() string {
+func (x *ReadMemoryRequest) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *ReadDirectoryRequest) GetPath() string {
+func (x *ReadMemoryRequest) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Path
+		return x.RequestAddressSpace
 	}
-	return ""
+	return AddressSpace_FxPakPro
 }
 
-type DirEntry struct {
+func (x *ReadMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *ReadMemoryRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type DirEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=DirEntryType" json:"type,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	// the address sent to the device and its space
+	DeviceAddress      uint32       `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
+	DeviceAddressSpace AddressSpace `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
+	Data               []byte       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *DirEntry) Reset() {
-	*x = DirEntry{}
+func (x *ReadMemoryResponse) Reset() {
+	*x = ReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1804,13 +1819,13 @@ func (x *DirEntry) Reset() {
 	}
 }
 
-func (x *DirEntry) String() string {
+func (x *ReadMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirEntry) ProtoMessage() {}
+func (*ReadMemoryResponse) ProtoMessage() {}
 
-func (x *DirEntry) ProtoReflect() protoreflect.Message {
+func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1822,37 +1837,66 @@ func (x *DirEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
-func (*DirEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadMemoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DirEntry) GetName() string {
+func (x *ReadMemoryResponse) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Name
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *DirEntry) GetType() DirEntryType {
+func (x *ReadMemoryResponse) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Type
+		return x.RequestAddressSpace
 	}
-	return DirEntryType_Directory
+	return AddressSpace_FxPakPro
 }
 
-type ReadDirectoryResponse struct {
+func (x *ReadMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *ReadMemoryResponse) GetDeviceAddress() uint32 {
+	if x != nil {
+		return x.DeviceAddress
+	}
+	return 0
+}
+
+func (x *ReadMemoryResponse) GetDeviceAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DeviceAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *ReadMemoryResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WriteMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path    string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Entries []*DirEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	Data                 []byte        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ReadDirectoryResponse) Reset() {
-	*x = ReadDirectoryResponse{}
+func (x *WriteMemoryRequest) Reset() {
+	*x = WriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1860,13 +1904,13 @@ func (x *ReadDirectoryResponse) Reset() {
 	}
 }
 
-func (x *ReadDirectoryResponse) String() string {
+func (x *WriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirectoryResponse) ProtoMessage() {}
+func (*WriteMemoryRequest) ProtoMessage() {}
 
-func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
+func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1878,43 +1922,54 @@ func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirectoryResponse.ProtoReflect.Descriptor instead.
-func (*ReadDirectoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*WriteMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ReadDirectoryResponse) GetUri() string {
+func (x *WriteMemoryRequest) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *ReadDirectoryResponse) GetPath() string {
+func (x *WriteMemoryRequest) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Path
+		return x.RequestAddressSpace
 	}
-	return ""
+	return AddressSpace_FxPakPro
 }
 
-func (x *ReadDirectoryResponse) GetEntries() []*DirEntry {
+func (x *WriteMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
 	if x != nil {
-		return x.Entries
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *WriteMemoryRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-type MakeDirectoryRequest struct {
+type WriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	DeviceAddress        uint32        `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
+	DeviceAddressSpace   AddressSpace  `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
+	Size                 uint32        `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *MakeDirectoryRequest) Reset() {
-	*x = MakeDirectoryRequest{}
+func (x *WriteMemoryResponse) Reset() {
+	*x = WriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1922,13 +1977,13 @@ func (x *MakeDirectoryRequest) Reset() {
 	}
 }
 
-func (x *MakeDirectoryRequest) String() string {
+func (x *WriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeDirectoryRequest) ProtoMessage() {}
+func (*WriteMemoryResponse) ProtoMessage() {}
 
-func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
+func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1940,36 +1995,64 @@ func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeDirectoryRequest.ProtoReflect.Descriptor instead.
-func (*MakeDirectoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*WriteMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *MakeDirectoryRequest) GetUri() string {
+func (x *WriteMemoryResponse) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *MakeDirectoryRequest) GetPath() string {
+func (x *WriteMemoryResponse) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Path
+		return x.RequestAddressSpace
 	}
-	return ""
+	return AddressSpace_FxPakPro
 }
 
-type MakeDirectoryResponse struct {
+func (x *WriteMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *WriteMemoryResponse) GetDeviceAddress() uint32 {
+	if x != nil {
+		return x.DeviceAddress
+	}
+	return 0
+}
+
+func (x *WriteMemoryResponse) GetDeviceAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DeviceAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *WriteMemoryResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SingleReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri     string             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Request *ReadMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *MakeDirectoryResponse) Reset() {
-	*x = MakeDirectoryResponse{}
+func (x *SingleReadMemoryRequest) Reset() {
+	*x = SingleReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1977,13 +2060,13 @@ func (x *MakeDirectoryResponse) Reset() {
 	}
 }
 
-func (x *MakeDirectoryResponse) String() string {
+func (x *SingleReadMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeDirectoryResponse) ProtoMessage() {}
+func (*SingleReadMemoryRequest) ProtoMessage() {}
 
-func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
+func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1995,36 +2078,36 @@ func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeDirectoryResponse.ProtoReflect.Descriptor instead.
-func (*MakeDirectoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleReadMemoryRequest.ProtoReflect.Descriptor instead.
+func (*SingleReadMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MakeDirectoryResponse) GetUri() string {
+func (x *SingleReadMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MakeDirectoryResponse) GetPath() string {
+func (x *SingleReadMemoryRequest) GetRequest() *ReadMemoryRequest {
 	if x != nil {
-		return x.Path
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-type RemoveFileRequest struct {
+type SingleReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri      string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Response *ReadMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *RemoveFileRequest) Reset() {
-	*x = RemoveFileRequest{}
+func (x *SingleReadMemoryResponse) Reset() {
+	*x = SingleReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2032,13 +2115,13 @@ func (x *RemoveFileRequest) Reset() {
 	}
 }
 
-func (x *RemoveFileRequest) String() string {
+func (x *SingleReadMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFileRequest) ProtoMessage() {}
+func (*SingleReadMemoryResponse) ProtoMessage() {}
 
-func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
+func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2050,36 +2133,36 @@ func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFileRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleReadMemoryResponse.ProtoReflect.Descriptor instead.
+func (*SingleReadMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *RemoveFileRequest) GetUri() string {
+func (x *SingleReadMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RemoveFileRequest) GetPath() string {
+func (x *SingleReadMemoryResponse) GetResponse() *ReadMemoryResponse {
 	if x != nil {
-		return x.Path
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-type RemoveFileResponse struct {
+type SingleWriteMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri     string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Request *WriteMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *RemoveFileResponse) Reset() {
-	*x = RemoveFileResponse{}
+func (x *SingleWriteMemoryRequest) Reset() {
+	*x = SingleWriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2087,13 +2170,13 @@ func (x *RemoveFileResponse) Reset() {
 	}
 }
 
-func (x *RemoveFileResponse) String() string {
+func (x *SingleWriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFileResponse) ProtoMessage() {}
+func (*SingleWriteMemoryRequest) ProtoMessage() {}
 
-func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
+func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2105,37 +2188,36 @@ func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFileResponse.ProtoReflect.Descriptor instead.
-func (*RemoveFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleWriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*SingleWriteMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RemoveFileResponse) GetUri() string {
+func (x *SingleWriteMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RemoveFileResponse) GetPath() string {
+func (x *SingleWriteMemoryRequest) GetRequest() *WriteMemoryRequest {
 	if x != nil {
-		return x.Path
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-type RenameFileRequest struct {
+type SingleWriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Response *WriteMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *RenameFileRequest) Reset() {
-	*x = RenameFileRequest{}
+func (x *SingleWriteMemoryResponse) Reset() {
+	*x = SingleWriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2143,13 +2225,13 @@ func (x *RenameFileRequest) Reset() {
 	}
 }
 
-func (x *RenameFileRequest) String() string {
+func (x *SingleWriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileRequest) ProtoMessage() {}
+func (*SingleWriteMemoryResponse) ProtoMessage() {}
 
-func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
+func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2161,44 +2243,36 @@ func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileRequest.ProtoReflect.Descriptor instead.
-func (*RenameFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleWriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*SingleWriteMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *RenameFileRequest) GetUri() string {
+func (x *SingleWriteMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RenameFileRequest) GetPath() string {
+func (x *SingleWriteMemoryResponse) GetResponse() *WriteMemoryResponse {
 	if x != nil {
-		return x.Path
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-func (x *RenameFileRequest) GetNewFilename() string {
-	if x != nil {
-		return x.NewFilename
-	}
-	return ""
-}
-
-type RenameFileResponse struct {
+type MultiReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Requests []*ReadMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *RenameFileResponse) Reset() {
-	*x = RenameFileResponse{}
+func (x *MultiReadMemoryRequest) Reset() {
+	*x = MultiReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2206,13 +2280,13 @@ func (x *RenameFileResponse) Reset() {
 	}
 }
 
-func (x *RenameFileResponse) String() string {
+func (x *MultiReadMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileResponse) ProtoMessage() {}
+func (*MultiReadMemoryRequest) ProtoMessage() {}
 
-func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
+func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2224,44 +2298,38 @@ func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileResponse.ProtoReflect.Descriptor instead.
-func (*RenameFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiReadMemoryRequest.ProtoReflect.Descriptor instead.
+func (*MultiReadMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RenameFileResponse) GetUri() string {
+func (x *MultiReadMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RenameFileResponse) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *RenameFileResponse) GetNewFilename() string {
+func (x *MultiReadMemoryRequest) GetRequests() []*ReadMemoryRequest {
 	if x != nil {
-		return x.NewFilename
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-type PutFileRequest struct {
+type MultiReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Uri       string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Responses []*ReadMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	// only set by StreamRead when the request failed:
+	Status *ResponseStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *PutFileRequest) Reset() {
-	*x = PutFileRequest{}
+func (x *MultiReadMemoryResponse) Reset() {
+	*x = MultiReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2269,13 +2337,13 @@ func (x *PutFileRequest) Reset() {
 	}
 }
 
-func (x *PutFileRequest) String() string {
+func (x *MultiReadMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutFileRequest) ProtoMessage() {}
+func (*MultiReadMemoryResponse) ProtoMessage() {}
 
-func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
+func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2287,44 +2355,43 @@ func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutFileRequest.ProtoReflect.Descriptor instead.
-func (*PutFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiReadMemoryResponse.ProtoReflect.Descriptor instead.
+func (*MultiReadMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *PutFileRequest) GetUri() string {
+func (x *MultiReadMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *PutFileRequest) GetPath() string {
+func (x *MultiReadMemoryResponse) GetResponses() []*ReadMemoryResponse {
 	if x != nil {
-		return x.Path
+		return x.Responses
 	}
-	return ""
+	return nil
 }
 
-func (x *PutFileRequest) GetData() []byte {
+func (x *MultiReadMemoryResponse) GetStatus() *ResponseStatus {
 	if x != nil {
-		return x.Data
+		return x.Status
 	}
 	return nil
 }
 
-type PutFileResponse struct {
+type MultiWriteMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Uri      string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Requests []*WriteMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *PutFileResponse) Reset() {
-	*x = PutFileResponse{}
+func (x *MultiWriteMemoryRequest) Reset() {
+	*x = MultiWriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2332,13 +2399,13 @@ func (x *PutFileResponse) Reset() {
 	}
 }
 
-func (x *PutFileResponse) String() string {
+func (x *MultiWriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutFileResponse) ProtoMessage() {}
+func (*MultiWriteMemoryRequest) ProtoMessage() {}
 
-func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
+func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2350,43 +2417,38 @@ func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutFileResponse.ProtoReflect.Descriptor instead.
-func (*PutFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiWriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*MultiWriteMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *PutFileResponse) GetUri() string {
+func (x *MultiWriteMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *PutFileResponse) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *PutFileResponse) GetSize() uint32 {
+func (x *MultiWriteMemoryRequest) GetRequests() []*WriteMemoryRequest {
 	if x != nil {
-		return x.Size
+		return x.Requests
 	}
-	return 0
+	return nil
 }
 
-type GetFileRequest struct {
+type MultiWriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri       string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Responses []*WriteMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	// only set by StreamWrite when the request failed:
+	Status *ResponseStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *GetFileRequest) Reset() {
-	*x = GetFileRequest{}
+func (x *MultiWriteMemoryResponse) Reset() {
+	*x = MultiWriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2394,13 +2456,13 @@ func (x *GetFileRequest) Reset() {
 	}
 }
 
-func (x *GetFileRequest) String() string {
+func (x *MultiWriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileRequest) ProtoMessage() {}
+func (*MultiWriteMemoryResponse) ProtoMessage() {}
 
-func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2412,38 +2474,47 @@ func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
-func (*GetFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiWriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*MultiWriteMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetFileRequest) GetUri() string {
+func (x *MultiWriteMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *GetFileRequest) GetPath() string {
+func (x *MultiWriteMemoryResponse) GetResponses() []*WriteMemoryResponse {
 	if x != nil {
-		return x.Path
+		return x.Responses
 	}
-	return ""
+	return nil
 }
 
-type GetFileResponse struct {
+func (x *MultiWriteMemoryResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// error status of an individual request in a stream:
+type ResponseStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// gRPC status code
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// true if the device connection was lost; the stream is closed after a fatal error
+	Fatal bool `protobuf:"varint,3,opt,name=fatal,proto3" json:"fatal,omitempty"`
 }
 
-func (x *GetFileResponse) Reset() {
-	*x = GetFileResponse{}
+func (x *ResponseStatus) Reset() {
+	*x = ResponseStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2451,13 +2522,13 @@ func (x *GetFileResponse) Reset() {
 	}
 }
 
-func (x *GetFileResponse) String() string {
+func (x *ResponseStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileResponse) ProtoMessage() {}
+func (*ResponseStatus) ProtoMessage() {}
 
-func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
+func (x *ResponseStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2469,50 +2540,49 @@ func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
-func (*GetFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseStatus.ProtoReflect.Descriptor instead.
+func (*ResponseStatus) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetFileResponse) GetUri() string {
+func (x *ResponseStatus) GetCode() int32 {
 	if x != nil {
-		return x.Uri
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFileResponse) GetPath() string {
+func (x *ResponseStatus) GetMessage() string {
 	if x != nil {
-		return x.Path
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GetFileResponse) GetSize() uint32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *GetFileResponse) GetData() []byte {
+func (x *ResponseStatus) GetFatal() bool {
 	if x != nil {
-		return x.Data
+		return x.Fatal
 	}
-	return nil
+	return false
 }
 
-type BootFileRequest struct {
+type CompareAndWriteMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri                  string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,2,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,3,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	// data expected to be present at the address; must be the same length as data:
+	Compare []byte `protobuf:"bytes,5,opt,name=compare,proto3" json:"compare,omitempty"`
+	// data to write if the compare data matches:
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *BootFileRequest) Reset() {
-	*x = BootFileRequest{}
+func (x *CompareAndWriteMemoryRequest) Reset() {
+	*x = CompareAndWriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2520,13 +2590,13 @@ func (x *BootFileRequest) Reset() {
 	}
 }
 
-func (x *BootFileRequest) String() string {
+func (x *CompareAndWriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootFileRequest) ProtoMessage() {}
+func (*CompareAndWriteMemoryRequest) ProtoMessage() {}
 
-func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
+func (x *CompareAndWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2538,106 +2608,85 @@ func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BootFileRequest.ProtoReflect.Descriptor instead.
-func (*BootFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompareAndWriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*CompareAndWriteMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *BootFileRequest) GetUri() string {
+func (x *CompareAndWriteMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *BootFileRequest) GetPath() string {
+func (x *CompareAndWriteMemoryRequest) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Path
+		return x.RequestAddress
 	}
-	return ""
-}
-
-type BootFileResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	return 0
 }
 
-func (x *BootFileResponse) Reset() {
-	*x = BootFileResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CompareAndWriteMemoryRequest) GetRequestAddressSpace() AddressSpace {
+	if x != nil {
+		return x.RequestAddressSpace
 	}
+	return AddressSpace_FxPakPro
 }
 
-func (x *BootFileResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BootFileResponse) ProtoMessage() {}
-
-func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CompareAndWriteMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BootFileResponse.ProtoReflect.Descriptor instead.
-func (*BootFileResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{38}
+	return MemoryMapping_Unknown
 }
 
-func (x *BootFileResponse) GetUri() string {
+func (x *CompareAndWriteMemoryRequest) GetCompare() []byte {
 	if x != nil {
-		return x.Uri
+		return x.Compare
 	}
-	return ""
+	return nil
 }
 
-func (x *BootFileResponse) GetPath() string {
+func (x *CompareAndWriteMemoryRequest) GetData() []byte {
 	if x != nil {
-		return x.Path
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type FieldsRequest struct {
+type CompareAndWriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri    string  `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Fields []Field `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
+	Uri                  string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,2,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,3,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	DeviceAddress        uint32        `protobuf:"varint,5,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
+	DeviceAddressSpace   AddressSpace  `protobuf:"varint,6,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
+	// true if the compare data matched and the data was written:
+	Written bool `protobuf:"varint,7,opt,name=written,proto3" json:"written,omitempty"`
 }
 
-func (x *FieldsRequest) Reset() {
-	*x = FieldsRequest{}
+func (x *CompareAndWriteMemoryResponse) Reset() {
+	*x = CompareAndWriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[39]
+		mi := &file_sni_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FieldsRequest) String() string {
+func (x *CompareAndWriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldsRequest) ProtoMessage() {}
+func (*CompareAndWriteMemoryResponse) ProtoMessage() {}
 
-func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[39]
+func (x *CompareAndWriteMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2648,37 +2697,139 @@ func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldsRequest.ProtoReflect.Descriptor instead.
-func (*FieldsRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CompareAndWriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*CompareAndWriteMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *FieldsRequest) GetUri() string {
+func (x *CompareAndWriteMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *FieldsRequest) GetFields() []Field {
+func (x *CompareAndWriteMemoryResponse) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Fields
+		return x.RequestAddress
 	}
-	return nil
+	return 0
 }
 
-type FieldsResponse struct {
+func (x *CompareAndWriteMemoryResponse) GetRequestAddressSpace() AddressSpace {
+	if x != nil {
+		return x.RequestAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *CompareAndWriteMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *CompareAndWriteMemoryResponse) GetDeviceAddress() uint32 {
+	if x != nil {
+		return x.DeviceAddress
+	}
+	return 0
+}
+
+func (x *CompareAndWriteMemoryResponse) GetDeviceAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DeviceAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *CompareAndWriteMemoryResponse) GetWritten() bool {
+	if x != nil {
+		return x.Written
+	}
+	return false
+}
+
+type WatchMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri    string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Fields []Field  `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
-	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Requests []*ReadMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// optional interval between device polls in nanoseconds; defaults to one SNES frame (~16.64ms) if 0
+	PollIntervalNanoseconds uint64 `protobuf:"varint,3,opt,name=pollIntervalNanoseconds,proto3" json:"pollIntervalNanoseconds,omitempty"`
 }
 
-func (x *FieldsResponse) Reset() {
-	*x = FieldsResponse{}
+func (x *WatchMemoryRequest) Reset() {
+	*x = WatchMemoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchMemoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMemoryRequest) ProtoMessage() {}
+
+func (x *WatchMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMemoryRequest.ProtoReflect.Descriptor instead.
+func (*WatchMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WatchMemoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *WatchMemoryRequest) GetRequests() []*ReadMemoryRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *WatchMemoryRequest) GetPollIntervalNanoseconds() uint64 {
+	if x != nil {
+		return x.PollIntervalNanoseconds
+	}
+	return 0
+}
+
+type WatchMemoryChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index into WatchMemoryRequest.requests of the memory segment that changed
+	RequestIndex uint32 `protobuf:"varint,1,opt,name=requestIndex,proto3" json:"requestIndex,omitempty"`
+	// the segment's address and its new data
+	Response *ReadMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	// the segment's data as of the previous poll; empty in the first message of the stream
+	OldData []byte `protobuf:"bytes,3,opt,name=oldData,proto3" json:"oldData,omitempty"`
+}
+
+func (x *WatchMemoryChange) Reset() {
+	*x = WatchMemoryChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2686,13 +2837,13 @@ func (x *FieldsResponse) Reset() {
 	}
 }
 
-func (x *FieldsResponse) String() string {
+func (x *WatchMemoryChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldsResponse) ProtoMessage() {}
+func (*WatchMemoryChange) ProtoMessage() {}
 
-func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchMemoryChange) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2704,48 +2855,46 @@ func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldsResponse.ProtoReflect.Descriptor instead.
-func (*FieldsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchMemoryChange.ProtoReflect.Descriptor instead.
+func (*WatchMemoryChange) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *FieldsResponse) GetUri() string {
+func (x *WatchMemoryChange) GetRequestIndex() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestIndex
 	}
-	return ""
+	return 0
 }
 
-func (x *FieldsResponse) GetFields() []Field {
+func (x *WatchMemoryChange) GetResponse() *ReadMemoryResponse {
 	if x != nil {
-		return x.Fields
+		return x.Response
 	}
 	return nil
 }
 
-func (x *FieldsResponse) GetValues() []string {
+func (x *WatchMemoryChange) GetOldData() []byte {
 	if x != nil {
-		return x.Values
+		return x.OldData
 	}
 	return nil
 }
 
-type NWACommandRequest struct {
+type WatchMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// emu-nwaccess command name:
-	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
-	// command arguments:
-	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
-	// an optional binary argument:
-	BinaryArg []byte `protobuf:"bytes,4,opt,name=binaryArg,proto3,oneof" json:"binaryArg,omitempty"`
+	// sequence number of this message in the stream, starting at 1
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// only the memory segments whose data changed since the previous poll; the first message contains all segments
+	Changes []*WatchMemoryChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *NWACommandRequest) Reset() {
-	*x = NWACommandRequest{}
+func (x *WatchMemoryResponse) Reset() {
+	*x = WatchMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2753,13 +2902,13 @@ func (x *NWACommandRequest) Reset() {
 	}
 }
 
-func (x *NWACommandRequest) String() string {
+func (x *WatchMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NWACommandRequest) ProtoMessage() {}
+func (*WatchMemoryResponse) ProtoMessage() {}
 
-func (x *NWACommandRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2771,51 +2920,48 @@ func (x *NWACommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NWACommandRequest.ProtoReflect.Descriptor instead.
-func (*NWACommandRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchMemoryResponse.ProtoReflect.Descriptor instead.
+func (*WatchMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *NWACommandRequest) GetUri() string {
+func (x *WatchMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *NWACommandRequest) GetCommand() string {
-	if x != nil {
-		return x.Command
-	}
-	return ""
-}
-
-func (x *NWACommandRequest) GetArgs() string {
+func (x *WatchMemoryResponse) GetSequence() uint64 {
 	if x != nil {
-		return x.Args
+		return x.Sequence
 	}
-	return ""
+	return 0
 }
 
-func (x *NWACommandRequest) GetBinaryArg() []byte {
+func (x *WatchMemoryResponse) GetChanges() []*WatchMemoryChange {
 	if x != nil {
-		return x.BinaryArg
+		return x.Changes
 	}
 	return nil
 }
 
-type NWACommandResponse struct {
+type ReadSymbolsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri          string                             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	AsciiReply   []*NWACommandResponse_NWAASCIIItem `protobuf:"bytes,2,rep,name=asciiReply,proto3" json:"asciiReply,omitempty"`
-	BinaryReplay []byte                             `protobuf:"bytes,3,opt,name=binaryReplay,proto3,oneof" json:"binaryReplay,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// names of the symbols to read:
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// memory mapping used to translate symbol addresses; detected from the ROM header if Unknown
+	MemoryMapping MemoryMapping `protobuf:"varint,3,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+	// optional symbol map name (its file name without extension); found by the loaded ROM's title or hash if empty
+	SymbolMap string `protobuf:"bytes,4,opt,name=symbolMap,proto3" json:"symbolMap,omitempty"`
 }
 
-func (x *NWACommandResponse) Reset() {
-	*x = NWACommandResponse{}
+func (x *ReadSymbolsRequest) Reset() {
+	*x = ReadSymbolsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2823,13 +2969,13 @@ func (x *NWACommandResponse) Reset() {
 	}
 }
 
-func (x *NWACommandResponse) String() string {
+func (x *ReadSymbolsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NWACommandResponse) ProtoMessage() {}
+func (*ReadSymbolsRequest) ProtoMessage() {}
 
-func (x *NWACommandResponse) ProtoReflect() protoreflect.Message {
+func (x *ReadSymbolsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2841,61 +2987,58 @@ func (x *NWACommandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NWACommandResponse.ProtoReflect.Descriptor instead.
-func (*NWACommandResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*ReadSymbolsRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *NWACommandResponse) GetUri() string {
+func (x *ReadSymbolsRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *NWACommandResponse) GetAsciiReply() []*NWACommandResponse_NWAASCIIItem {
+func (x *ReadSymbolsRequest) GetNames() []string {
 	if x != nil {
-		return x.AsciiReply
+		return x.Names
 	}
 	return nil
 }
 
-func (x *NWACommandResponse) GetBinaryReplay() []byte {
+func (x *ReadSymbolsRequest) GetMemoryMapping() MemoryMapping {
 	if x != nil {
-		return x.BinaryReplay
+		return x.MemoryMapping
 	}
-	return nil
+	return MemoryMapping_Unknown
 }
 
-type DevicesResponse_Device struct {
+func (x *ReadSymbolsRequest) GetSymbolMap() string {
+	if x != nil {
+		return x.SymbolMap
+	}
+	return ""
+}
+
+type SymbolValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// URI that describes exactly how to connect to the device, e.g.:
-	// RetroArch:  "ra://127.0.0.1:55355"
-	// FX Pak Pro: "fxpakpro://./dev/cu.usbmodemDEMO000000001" (MacOS)
-	//             "fxpakpro://./COM4"                         (Windows)
-	//             "fxpakpro://./dev/ttyACM0"                  (Linux)
-	// uri is used as the unique identifier of the device for clients to refer to
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// friendly display name of the device
-	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	// device kind, e.g. "fxpakpro", "retroarch", "lua"
-	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
-	// all device capabilities:
-	Capabilities []DeviceCapability `protobuf:"varint,4,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
-	// default address space for the device:
-	DefaultAddressSpace AddressSpace `protobuf:"varint,5,opt,name=defaultAddressSpace,proto3,enum=AddressSpace" json:"defaultAddressSpace,omitempty"`
-	// [DEPRECATED] console system supported, e.g. "snes", "n64"
-	// since devices can support multiple systems, it's better to fetch platform from DeviceInfo.FetchFields method
-	//
-	// Deprecated: Do not use.
-	System string `protobuf:"bytes,6,opt,name=system,proto3" json:"system,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// u8, u16le, bitfield, or bcd:
+	Type         string       `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Address      uint32       `protobuf:"varint,3,opt,name=address,proto3" json:"address,omitempty"`
+	AddressSpace AddressSpace `protobuf:"varint,4,opt,name=addressSpace,proto3,enum=AddressSpace" json:"addressSpace,omitempty"`
+	// raw bytes read for the symbol:
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// decoded value of the symbol:
+	Value       uint64 `protobuf:"varint,6,opt,name=value,proto3" json:"value,omitempty"`
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *DevicesResponse_Device) Reset() {
-	*x = DevicesResponse_Device{}
+func (x *SymbolValue) Reset() {
+	*x = SymbolValue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2903,13 +3046,13 @@ func (x *DevicesResponse_Device) Reset() {
 	}
 }
 
-func (x *DevicesResponse_Device) String() string {
+func (x *SymbolValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DevicesResponse_Device) ProtoMessage() {}
+func (*SymbolValue) ProtoMessage() {}
 
-func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
+func (x *SymbolValue) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2921,64 +3064,74 @@ func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DevicesResponse_Device.ProtoReflect.Descriptor instead.
-func (*DevicesResponse_Device) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use SymbolValue.ProtoReflect.Descriptor instead.
+func (*SymbolValue) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DevicesResponse_Device) GetUri() string {
+func (x *SymbolValue) GetName() string {
 	if x != nil {
-		return x.Uri
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DevicesResponse_Device) GetDisplayName() string {
+func (x *SymbolValue) GetType() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Type
 	}
 	return ""
 }
 
-func (x *DevicesResponse_Device) GetKind() string {
+func (x *SymbolValue) GetAddress() uint32 {
 	if x != nil {
-		return x.Kind
+		return x.Address
 	}
-	return ""
+	return 0
 }
 
-func (x *DevicesResponse_Device) GetCapabilities() []DeviceCapability {
+func (x *SymbolValue) GetAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Capabilities
+		return x.AddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *SymbolValue) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-func (x *DevicesResponse_Device) GetDefaultAddressSpace() AddressSpace {
+func (x *SymbolValue) GetValue() uint64 {
 	if x != nil {
-		return x.DefaultAddressSpace
+		return x.Value
 	}
-	return AddressSpace_FxPakPro
+	return 0
 }
 
-// Deprecated: Do not use.
-func (x *DevicesResponse_Device) GetSystem() string {
+func (x *SymbolValue) GetDescription() string {
 	if x != nil {
-		return x.System
+		return x.Description
 	}
 	return ""
 }
 
-type NWACommandResponse_NWAASCIIItem struct {
+type ReadSymbolsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Item map[string]string `protobuf:"bytes,1,rep,name=item,proto3" json:"item,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// name of the symbol map the symbols were read from:
+	SymbolMap string `protobuf:"bytes,2,opt,name=symbolMap,proto3" json:"symbolMap,omitempty"`
+	// values in the same order as the requested names:
+	Values []*SymbolValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *NWACommandResponse_NWAASCIIItem) Reset() {
-	*x = NWACommandResponse_NWAASCIIItem{}
+func (x *ReadSymbolsResponse) Reset() {
+	*x = ReadSymbolsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2986,620 +3139,3856 @@ func (x *NWACommandResponse_NWAASCIIItem) Reset() {
 	}
 }
 
-func (x *NWACommandResponse_NWAASCIIItem) String() string {
+func (x *ReadSymbolsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NWACommandResponse_NWAASCIIItem) ProtoMessage() {}
+func (*ReadSymbolsResponse) ProtoMessage() {}
 
-func (x *NWACommandResponse_NWAASCIIItem) ProtoReflect() protoreflect.Message {
+func (x *ReadSymbolsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use NWACommandResponse_NWAASCIIItem.ProtoReflect.Descriptor instead.
-func (*NWACommandResponse_NWAASCIIItem) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{42, 0}
-}
-
-func (x *NWACommandResponse_NWAASCIIItem) GetItem() map[string]string {
-	if x != nil {
-		return x.Item
-	}
-	return nil
-}
-
-var File_sni_proto protoreflect.FileDescriptor
-
-var file_sni_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x73, 0x6e, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x0e, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69,
-	0x6e, 0x64, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0xe4, 0x01, 0x0a, 0x06, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a,
-	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e,
-	0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x22, 0x41, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x1b, 0x50, 0x61,
-	0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x30, 0x0a, 0x1c, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0xd6, 0x01,
-	0x0a, 0x1a, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x49,
-	0x0a, 0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52,
-	0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x72, 0x6f, 0x6d,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30,
-	0x30, 0x46, 0x46, 0x42, 0x30, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x66, 0x61, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xaf, 0x01, 0x0a, 0x1b, 0x44, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x34, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
-	0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28,
-	0x0a, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42,
-	0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xd4, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x61,
-	0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
-	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
-	0xba, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f,
-	0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd5, 0x01, 0x0a,
-	0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x02, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x22, 0x59, 0x0a, 0x17, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a,
-	0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x18,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x19, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x16, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52,
-	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x62, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x4d,
-	0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3d, 0x0a, 0x15, 0x4d, 0x61, 0x6b,
-	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x5b, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65,
-	0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x12,
-	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46,
-	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
-	0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x0e, 0x50, 0x75,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5f, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x0f,
-	0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x41, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x84,
-	0x01, 0x0a, 0x11, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x61, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x41, 0x72, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x41, 0x72, 0x67, 0x22, 0xac, 0x02, 0x0a, 0x12, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x40,
-	0x0a, 0x0a, 0x61, 0x73, 0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x73, 0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x27, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01, 0x1a, 0x87, 0x01, 0x0a, 0x0c, 0x4e, 0x57,
-	0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3e, 0x0a, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57,
-	0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x74,
-	0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x70, 0x6c, 0x61, 0x79, 0x2a, 0x33, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x78, 0x50, 0x61, 0x6b, 0x50, 0x72, 0x6f,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x6e, 0x65, 0x73, 0x41, 0x42, 0x75, 0x73, 0x10, 0x01,
-	0x12, 0x07, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x10, 0x02, 0x2a, 0x48, 0x0a, 0x0d, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x69, 0x52, 0x4f, 0x4d,
-	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x6f, 0x52, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x0b, 0x0a,
-	0x07, 0x45, 0x78, 0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x41,
-	0x31, 0x10, 0x04, 0x2a, 0xb3, 0x02, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61,
-	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53,
-	0x4d, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55, 0x6e, 0x70,
-	0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x05, 0x12,
-	0x18, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x0a, 0x12, 0x11,
-	0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10,
-	0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x10,
-	0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x10,
-	0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0e, 0x12, 0x0b,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0f, 0x12, 0x0c, 0x0a, 0x08, 0x42,
-	0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x57, 0x41,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x10, 0x14, 0x2a, 0xa1, 0x01, 0x0a, 0x05, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x6f, 0x72, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x10, 0x14, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6f, 0x72, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x15, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6f, 0x72, 0x65, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x10, 0x16, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f, 0x6d,
-	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x28, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f,
-	0x6d, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x10, 0x29, 0x12, 0x10, 0x0a, 0x0c, 0x52,
-	0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x2a, 0x2a, 0x27, 0x0a,
-	0x0c, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a,
-	0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x46, 0x69, 0x6c, 0x65, 0x10, 0x01, 0x32, 0x3d, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x32, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0x0f, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xaa, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65,
-	0x6e, 0x75, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54,
-	0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4a, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65,
-	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x32, 0x81, 0x04, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12,
-	0x18, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x09, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18,
-	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0b,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x32, 0x9b, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x40, 0x0a, 0x0d, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15,
-	0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x50,
-	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x31, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e,
-	0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x32, 0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x12, 0x0e, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x32, 0x44, 0x0a, 0x09, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x57,
-	0x41, 0x12, 0x37, 0x0a, 0x0a, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
-	0x12, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3f, 0x0a, 0x15, 0x63, 0x6f,
-	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2e,
-	0x73, 0x6e, 0x69, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2f, 0x73, 0x6e, 0x69, 0xaa, 0x02, 0x03, 0x53, 0x4e, 0x49, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_sni_proto_rawDescOnce sync.Once
-	file_sni_proto_rawDescData = file_sni_proto_rawDesc
-)
-
-func file_sni_proto_rawDescGZIP() []byte {
-	file_sni_proto_rawDescOnce.Do(func() {
-		file_sni_proto_rawDescData = protoimpl.X.CompressGZIP(file_sni_proto_rawDescData)
-	})
-	return file_sni_proto_rawDescData
-}
-
-var file_sni_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_sni_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_sni_proto_goTypes = []interface{}{
-	(AddressSpace)(0),                       // 0: AddressSpace
-	(MemoryMapping)(0),                      // 1: MemoryMapping
-	(DeviceCapability)(0),                   // 2: DeviceCapability
-	(Field)(0),                              // 3: Field
-	(DirEntryType)(0),                       // 4: DirEntryType
-	(*DevicesRequest)(nil),                  // 5: DevicesRequest
-	(*DevicesResponse)(nil),                 // 6: DevicesResponse
-	(*ResetSystemRequest)(nil),              // 7: ResetSystemRequest
-	(*ResetSystemResponse)(nil),             // 8: ResetSystemResponse
-	(*ResetToMenuRequest)(nil),              // 9: ResetToMenuRequest
-	(*ResetToMenuResponse)(nil),             // 10: ResetToMenuResponse
-	(*PauseEmulationRequest)(nil),           // 11: PauseEmulationRequest
-	(*PauseEmulationResponse)(nil),          // 12: PauseEmulationResponse
-	(*PauseToggleEmulationRequest)(nil),     // 13: PauseToggleEmulationRequest
-	(*PauseToggleEmulationResponse)(nil),    // 14: PauseToggleEmulationResponse
-	(*DetectMemoryMappingRequest)(nil),      // 15: DetectMemoryMappingRequest
-	(*DetectMemoryMappingResponse)(nil),     // 16: DetectMemoryMappingResponse
-	(*ReadMemoryRequest)(nil),               // 17: ReadMemoryRequest
-	(*ReadMemoryResponse)(nil),              // 18: ReadMemoryResponse
-	(*WriteMemoryRequest)(nil),              // 19: WriteMemoryRequest
-	(*WriteMemoryResponse)(nil),             // 20: WriteMemoryResponse
-	(*SingleReadMemoryRequest)(nil),         // 21: SingleReadMemoryRequest
-	(*SingleReadMemoryResponse)(nil),        // 22: SingleReadMemoryResponse
-	(*SingleWriteMemoryRequest)(nil),        // 23: SingleWriteMemoryRequest
-	(*SingleWriteMemoryResponse)(nil),       // 24: SingleWriteMemoryResponse
-	(*MultiReadMemoryRequest)(nil),          // 25: MultiReadMemoryRequest
-	(*MultiReadMemoryResponse)(nil),         // 26: MultiReadMemoryResponse
-	(*MultiWriteMemoryRequest)(nil),         // 27: MultiWriteMemoryRequest
-	(*MultiWriteMemoryResponse)(nil),        // 28: MultiWriteMemoryResponse
-	(*ReadDirectoryRequest)(nil),            // 29: ReadDirectoryRequest
-	(*DirEntry)(nil),                        // 30: DirEntry
-	(*ReadDirectoryResponse)(nil),           // 31: ReadDirectoryResponse
-	(*MakeDirectoryRequest)(nil),            // 32: MakeDirectoryRequest
-	(*MakeDirectoryResponse)(nil),           // 33: MakeDirectoryResponse
-	(*RemoveFileRequest)(nil),               // 34: RemoveFileRequest
-	(*RemoveFileResponse)(nil),              // 35: RemoveFileResponse
-	(*RenameFileRequest)(nil),               // 36: RenameFileRequest
-	(*RenameFileResponse)(nil),              // 37: RenameFileResponse
-	(*PutFileRequest)(nil),                  // 38: PutFileRequest
-	(*PutFileResponse)(nil),                 // 39: PutFileResponse
-	(*GetFileRequest)(nil),                  // 40: GetFileRequest
-	(*GetFileResponse)(nil),                 // 41: GetFileResponse
-	(*BootFileRequest)(nil),                 // 42: BootFileRequest
-	(*BootFileResponse)(nil),                // 43: BootFileResponse
-	(*FieldsRequest)(nil),                   // 44: FieldsRequest
-	(*FieldsResponse)(nil),                  // 45: FieldsResponse
-	(*NWACommandRequest)(nil),               // 46: NWACommandRequest
-	(*NWACommandResponse)(nil),              // 47: NWACommandResponse
-	(*DevicesResponse_Device)(nil),          // 48: DevicesResponse.Device
-	(*NWACommandResponse_NWAASCIIItem)(nil), // 49: NWACommandResponse.NWAASCIIItem
-	nil,                                     // 50: NWACommandResponse.NWAASCIIItem.ItemEntry
-}
-var file_sni_proto_depIdxs = []int32{
-	48, // 0: DevicesResponse.devices:type_name -> DevicesResponse.Device
-	1,  // 1: DetectMemoryMappingRequest.fallbackMemoryMapping:type_name -> MemoryMapping
-	1,  // 2: DetectMemoryMappingResponse.memoryMapping:type_name -> MemoryMapping
-	0,  // 3: ReadMemoryRequest.requestAddressSpace:type_name -> AddressSpace
-	1,  // 4: ReadMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 5: ReadMemoryResponse.requestAddressSpace:type_name -> AddressSpace
-	1,  // 6: ReadMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 7: ReadMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
-	0,  // 8: WriteMemoryRequest.requestAddressSpace:type_name -> AddressSpace
-	1,  // 9: WriteMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 10: WriteMemoryResponse.requestAddressSpace:type_name -> AddressSpace
-	1,  // 11: WriteMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 12: WriteMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
-	17, // 13: SingleReadMemoryRequest.request:type_name -> ReadMemoryRequest
-	18, // 14: SingleReadMemoryResponse.response:type_name -> ReadMemoryResponse
-	19, // 15: SingleWriteMemoryRequest.request:type_name -> WriteMemoryRequest
-	20, // 16: SingleWriteMemoryResponse.response:type_name -> WriteMemoryResponse
-	17, // 17: MultiReadMemoryRequest.requests:type_name -> ReadMemoryRequest
-	18, // 18: MultiReadMemoryResponse.responses:type_name -> ReadMemoryResponse
-	19, // 19: MultiWriteMemoryRequest.requests:type_name -> WriteMemoryRequest
-	20, // 20: MultiWriteMemoryResponse.responses:type_name -> WriteMemoryResponse
-	4,  // 21: DirEntry.type:type_name -> DirEntryType
-	30, // 22: ReadDirectoryResponse.entries:type_name -> DirEntry
-	3,  // 23: FieldsRequest.fields:type_name -> Field
-	3,  // 24: FieldsResponse.fields:type_name -> Field
-	49, // 25: NWACommandResponse.asciiReply:type_name -> NWACommandResponse.NWAASCIIItem
-	2,  // 26: DevicesResponse.Device.capabilities:type_name -> DeviceCapability
-	0,  // 27: DevicesResponse.Device.defaultAddressSpace:type_name -> AddressSpace
-	50, // 28: NWACommandResponse.NWAASCIIItem.item:type_name -> NWACommandResponse.NWAASCIIItem.ItemEntry
-	5,  // 29: Devices.ListDevices:input_type -> DevicesRequest
-	7,  // 30: DeviceControl.ResetSystem:input_type -> ResetSystemRequest
-	9,  // 31: DeviceControl.ResetToMenu:input_type -> ResetToMenuRequest
-	11, // 32: DeviceControl.PauseUnpauseEmulation:input_type -> PauseEmulationRequest
-	13, // 33: DeviceControl.PauseToggleEmulation:input_type -> PauseToggleEmulationRequest
-	15, // 34: DeviceMemory.MappingDetect:input_type -> DetectMemoryMappingRequest
-	21, // 35: DeviceMemory.SingleRead:input_type -> SingleReadMemoryRequest
-	23, // 36: DeviceMemory.SingleWrite:input_type -> SingleWriteMemoryRequest
-	25, // 37: DeviceMemory.MultiRead:input_type -> MultiReadMemoryRequest
-	27, // 38: DeviceMemory.MultiWrite:input_type -> MultiWriteMemoryRequest
-	25, // 39: DeviceMemory.StreamRead:input_type -> MultiReadMemoryRequest
-	27, // 40: DeviceMemory.StreamWrite:input_type -> MultiWriteMemoryRequest
-	29, // 41: DeviceFilesystem.ReadDirectory:input_type -> ReadDirectoryRequest
-	32, // 42: DeviceFilesystem.MakeDirectory:input_type -> MakeDirectoryRequest
-	34, // 43: DeviceFilesystem.RemoveFile:input_type -> RemoveFileRequest
-	36, // 44: DeviceFilesystem.RenameFile:input_type -> RenameFileRequest
-	38, // 45: DeviceFilesystem.PutFile:input_type -> PutFileRequest
-	40, // 46: DeviceFilesystem.GetFile:input_type -> GetFileRequest
-	42, // 47: DeviceFilesystem.BootFile:input_type -> BootFileRequest
-	44, // 48: DeviceInfo.FetchFields:input_type -> FieldsRequest
-	46, // 49: DeviceNWA.NWACommand:input_type -> NWACommandRequest
-	6,  // 50: Devices.ListDevices:output_type -> DevicesResponse
-	8,  // 51: DeviceControl.ResetSystem:output_type -> ResetSystemResponse
-	10, // 52: DeviceControl.ResetToMenu:output_type -> ResetToMenuResponse
-	12, // 53: DeviceControl.PauseUnpauseEmulation:output_type -> PauseEmulationResponse
-	14, // 54: DeviceControl.PauseToggleEmulation:output_type -> PauseToggleEmulationResponse
-	16, // 55: DeviceMemory.MappingDetect:output_type -> DetectMemoryMappingResponse
-	22, // 56: DeviceMemory.SingleRead:output_type -> SingleReadMemoryResponse
-	24, // 57: DeviceMemory.SingleWrite:output_type -> SingleWriteMemoryResponse
-	26, // 58: DeviceMemory.MultiRead:output_type -> MultiReadMemoryResponse
-	28, // 59: DeviceMemory.MultiWrite:output_type -> MultiWriteMemoryResponse
-	26, // 60: DeviceMemory.StreamRead:output_type -> MultiReadMemoryResponse
-	28, // 61: DeviceMemory.StreamWrite:output_type -> MultiWriteMemoryResponse
-	31, // 62: DeviceFilesystem.ReadDirectory:output_type -> ReadDirectoryResponse
-	33, // 63: DeviceFilesystem.MakeDirectory:output_type -> MakeDirectoryResponse
-	35, // 64: DeviceFilesystem.RemoveFile:output_type -> RemoveFileResponse
-	37, // 65: DeviceFilesystem.RenameFile:output_type -> RenameFileResponse
-	39, // 66: DeviceFilesystem.PutFile:output_type -> PutFileResponse
-	41, // 67: DeviceFilesystem.GetFile:output_type -> GetFileResponse
-	43, // 68: DeviceFilesystem.BootFile:output_type -> BootFileResponse
-	45, // 69: DeviceInfo.FetchFields:output_type -> FieldsResponse
-	47, // 70: DeviceNWA.NWACommand:output_type -> NWACommandResponse
-	50, // [50:71] is the sub-list for method output_type
-	29, // [29:50] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
-}
-
-func init() { file_sni_proto_init() }
-func file_sni_proto_init() {
-	if File_sni_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_sni_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevicesRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*ReadSymbolsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReadSymbolsResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ReadSymbolsResponse) GetSymbolMap() string {
+	if x != nil {
+		return x.SymbolMap
+	}
+	return ""
+}
+
+func (x *ReadSymbolsResponse) GetValues() []*SymbolValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ReadDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ReadDirectoryRequest) Reset() {
+	*x = ReadDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDirectoryRequest) ProtoMessage() {}
+
+func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReadDirectoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ReadDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type DirEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type DirEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=DirEntryType" json:"type,omitempty"`
+}
+
+func (x *DirEntry) Reset() {
+	*x = DirEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirEntry) ProtoMessage() {}
+
+func (x *DirEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
+func (*DirEntry) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DirEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DirEntry) GetType() DirEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return DirEntryType_Directory
+}
+
+type ReadDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri     string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path    string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Entries []*DirEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ReadDirectoryResponse) Reset() {
+	*x = ReadDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDirectoryResponse) ProtoMessage() {}
+
+func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReadDirectoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ReadDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadDirectoryResponse) GetEntries() []*DirEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MakeDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MakeDirectoryRequest) Reset() {
+	*x = MakeDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeDirectoryRequest) ProtoMessage() {}
+
+func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*MakeDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MakeDirectoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MakeDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type MakeDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MakeDirectoryResponse) Reset() {
+	*x = MakeDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeDirectoryResponse) ProtoMessage() {}
+
+func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*MakeDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MakeDirectoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MakeDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveFileRequest) Reset() {
+	*x = RemoveFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFileRequest) ProtoMessage() {}
+
+func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFileRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RemoveFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveFileResponse) Reset() {
+	*x = RemoveFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFileResponse) ProtoMessage() {}
+
+func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFileResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RemoveFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RenameFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+}
+
+func (x *RenameFileRequest) Reset() {
+	*x = RenameFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFileRequest) ProtoMessage() {}
+
+func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFileRequest.ProtoReflect.Descriptor instead.
+func (*RenameFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RenameFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RenameFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RenameFileRequest) GetNewFilename() string {
+	if x != nil {
+		return x.NewFilename
+	}
+	return ""
+}
+
+type RenameFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+}
+
+func (x *RenameFileResponse) Reset() {
+	*x = RenameFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFileResponse) ProtoMessage() {}
+
+func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFileResponse.ProtoReflect.Descriptor instead.
+func (*RenameFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RenameFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RenameFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RenameFileResponse) GetNewFilename() string {
+	if x != nil {
+		return x.NewFilename
+	}
+	return ""
+}
+
+type PutFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PutFileRequest) Reset() {
+	*x = PutFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileRequest) ProtoMessage() {}
+
+func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileRequest.ProtoReflect.Descriptor instead.
+func (*PutFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PutFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PutFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *PutFileResponse) Reset() {
+	*x = PutFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileResponse) ProtoMessage() {}
+
+func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileResponse.ProtoReflect.Descriptor instead.
+func (*PutFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PutFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetFileResponse) Reset() {
+	*x = GetFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileResponse) ProtoMessage() {}
+
+func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
+func (*GetFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetFileResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetFileResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BootFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BootFileRequest) Reset() {
+	*x = BootFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootFileRequest) ProtoMessage() {}
+
+func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootFileRequest.ProtoReflect.Descriptor instead.
+func (*BootFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BootFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BootFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type BootFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BootFileResponse) Reset() {
+	*x = BootFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootFileResponse) ProtoMessage() {}
+
+func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootFileResponse.ProtoReflect.Descriptor instead.
+func (*BootFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BootFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BootFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FieldsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string  `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Fields []Field `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
+}
+
+func (x *FieldsRequest) Reset() {
+	*x = FieldsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldsRequest) ProtoMessage() {}
+
+func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldsRequest.ProtoReflect.Descriptor instead.
+func (*FieldsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FieldsRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *FieldsRequest) GetFields() []Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type FieldsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Fields []Field  `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *FieldsResponse) Reset() {
+	*x = FieldsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldsResponse) ProtoMessage() {}
+
+func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldsResponse.ProtoReflect.Descriptor instead.
+func (*FieldsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FieldsResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *FieldsResponse) GetFields() []Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *FieldsResponse) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type NWACommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// emu-nwaccess command name:
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// command arguments:
+	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	// an optional binary argument:
+	BinaryArg []byte `protobuf:"bytes,4,opt,name=binaryArg,proto3,oneof" json:"binaryArg,omitempty"`
+}
+
+func (x *NWACommandRequest) Reset() {
+	*x = NWACommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACommandRequest) ProtoMessage() {}
+
+func (x *NWACommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACommandRequest.ProtoReflect.Descriptor instead.
+func (*NWACommandRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *NWACommandRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *NWACommandRequest) GetArgs() string {
+	if x != nil {
+		return x.Args
+	}
+	return ""
+}
+
+func (x *NWACommandRequest) GetBinaryArg() []byte {
+	if x != nil {
+		return x.BinaryArg
+	}
+	return nil
+}
+
+type NWACommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri          string                             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	AsciiReply   []*NWACommandResponse_NWAASCIIItem `protobuf:"bytes,2,rep,name=asciiReply,proto3" json:"asciiReply,omitempty"`
+	BinaryReplay []byte                             `protobuf:"bytes,3,opt,name=binaryReplay,proto3,oneof" json:"binaryReplay,omitempty"`
+}
+
+func (x *NWACommandResponse) Reset() {
+	*x = NWACommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACommandResponse) ProtoMessage() {}
+
+func (x *NWACommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACommandResponse.ProtoReflect.Descriptor instead.
+func (*NWACommandResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *NWACommandResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACommandResponse) GetAsciiReply() []*NWACommandResponse_NWAASCIIItem {
+	if x != nil {
+		return x.AsciiReply
+	}
+	return nil
+}
+
+func (x *NWACommandResponse) GetBinaryReplay() []byte {
+	if x != nil {
+		return x.BinaryReplay
+	}
+	return nil
+}
+
+type ExecuteASMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// 65816 machine code to execute once during the next NMI; code is called via JSL and must return via RTL.
+	// the load address is device-specific; for fxpakpro the code is loaded at $00:2C20 and may be at most 480 bytes.
+	Code []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// wait for the code to complete executing before responding:
+	WaitForCompletion bool `protobuf:"varint,3,opt,name=waitForCompletion,proto3" json:"waitForCompletion,omitempty"`
+	// optional memory to read back as results after the code completes; implies waitForCompletion:
+	Results []*ReadMemoryRequest `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ExecuteASMRequest) Reset() {
+	*x = ExecuteASMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteASMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteASMRequest) ProtoMessage() {}
+
+func (x *ExecuteASMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteASMRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteASMRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ExecuteASMRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ExecuteASMRequest) GetCode() []byte {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *ExecuteASMRequest) GetWaitForCompletion() bool {
+	if x != nil {
+		return x.WaitForCompletion
+	}
+	return false
+}
+
+func (x *ExecuteASMRequest) GetResults() []*ReadMemoryRequest {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExecuteASMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// true if the code was confirmed to have completed executing:
+	Completed bool                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Results   []*ReadMemoryResponse `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ExecuteASMResponse) Reset() {
+	*x = ExecuteASMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteASMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteASMResponse) ProtoMessage() {}
+
+func (x *ExecuteASMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteASMResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteASMResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ExecuteASMResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ExecuteASMResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ExecuteASMResponse) GetResults() []*ReadMemoryResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// a memory region in the FX Pak Pro address space:
+type SnapshotRegion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address uint32 `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Size    uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *SnapshotRegion) Reset() {
+	*x = SnapshotRegion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotRegion) ProtoMessage() {}
+
+func (x *SnapshotRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotRegion.ProtoReflect.Descriptor instead.
+func (*SnapshotRegion) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SnapshotRegion) GetAddress() uint32 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *SnapshotRegion) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SnapshotInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// time the snapshot was captured, in nanoseconds since the unix epoch
+	CreatedAtUnixNanoseconds int64 `protobuf:"varint,2,opt,name=createdAtUnixNanoseconds,proto3" json:"createdAtUnixNanoseconds,omitempty"`
+	// identity of the ROM the snapshot was captured from:
+	RomFileName  string `protobuf:"bytes,3,opt,name=romFileName,proto3" json:"romFileName,omitempty"`
+	RomHashType  string `protobuf:"bytes,4,opt,name=romHashType,proto3" json:"romHashType,omitempty"`
+	RomHashValue string `protobuf:"bytes,5,opt,name=romHashValue,proto3" json:"romHashValue,omitempty"`
+	// regions stored in the snapshot; regions the device could not read are left out:
+	Regions []*SnapshotRegion `protobuf:"bytes,6,rep,name=regions,proto3" json:"regions,omitempty"`
+}
+
+func (x *SnapshotInfo) Reset() {
+	*x = SnapshotInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotInfo) ProtoMessage() {}
+
+func (x *SnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotInfo.ProtoReflect.Descriptor instead.
+func (*SnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SnapshotInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetCreatedAtUnixNanoseconds() int64 {
+	if x != nil {
+		return x.CreatedAtUnixNanoseconds
+	}
+	return 0
+}
+
+func (x *SnapshotInfo) GetRomFileName() string {
+	if x != nil {
+		return x.RomFileName
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetRomHashType() string {
+	if x != nil {
+		return x.RomHashType
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetRomHashValue() string {
+	if x != nil {
+		return x.RomHashValue
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetRegions() []*SnapshotRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type CaptureSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// name to store the snapshot as; an existing snapshot with the same name is replaced
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// regions to capture; defaults to the configured snapshot regions if empty
+	Regions []*SnapshotRegion `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`
+}
+
+func (x *CaptureSnapshotRequest) Reset() {
+	*x = CaptureSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptureSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureSnapshotRequest) ProtoMessage() {}
+
+func (x *CaptureSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CaptureSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CaptureSnapshotRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *CaptureSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CaptureSnapshotRequest) GetRegions() []*SnapshotRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type CaptureSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri      string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Snapshot *SnapshotInfo `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *CaptureSnapshotResponse) Reset() {
+	*x = CaptureSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptureSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureSnapshotResponse) ProtoMessage() {}
+
+func (x *CaptureSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CaptureSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CaptureSnapshotResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *CaptureSnapshotResponse) GetSnapshot() *SnapshotInfo {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type RestoreSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RestoreSnapshotRequest) Reset() {
+	*x = RestoreSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RestoreSnapshotRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RestoreSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestoreSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri      string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Snapshot *SnapshotInfo `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *RestoreSnapshotResponse) Reset() {
+	*x = RestoreSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotResponse) ProtoMessage() {}
+
+func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RestoreSnapshotResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RestoreSnapshotResponse) GetSnapshot() *SnapshotInfo {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ListSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{72}
+}
+
+type ListSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*SnapshotInfo `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*SnapshotInfo {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type DeleteSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteSnapshotRequest) Reset() {
+	*x = DeleteSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteSnapshotResponse) Reset() {
+	*x = DeleteSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSnapshotResponse) ProtoMessage() {}
+
+func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteSnapshotResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SaveStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// save state slot; the emulator's current slot is used if absent. not all emulators have a current slot.
+	Slot *uint32 `protobuf:"varint,2,opt,name=slot,proto3,oneof" json:"slot,omitempty"`
+}
+
+func (x *SaveStateRequest) Reset() {
+	*x = SaveStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveStateRequest) ProtoMessage() {}
+
+func (x *SaveStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveStateRequest.ProtoReflect.Descriptor instead.
+func (*SaveStateRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SaveStateRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *SaveStateRequest) GetSlot() uint32 {
+	if x != nil && x.Slot != nil {
+		return *x.Slot
+	}
+	return 0
+}
+
+type SaveStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *SaveStateResponse) Reset() {
+	*x = SaveStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveStateResponse) ProtoMessage() {}
+
+func (x *SaveStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveStateResponse.ProtoReflect.Descriptor instead.
+func (*SaveStateResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SaveStateResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type LoadStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// save state slot; the emulator's current slot is used if absent. not all emulators have a current slot.
+	Slot *uint32 `protobuf:"varint,2,opt,name=slot,proto3,oneof" json:"slot,omitempty"`
+}
+
+func (x *LoadStateRequest) Reset() {
+	*x = LoadStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadStateRequest) ProtoMessage() {}
+
+func (x *LoadStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadStateRequest.ProtoReflect.Descriptor instead.
+func (*LoadStateRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *LoadStateRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *LoadStateRequest) GetSlot() uint32 {
+	if x != nil && x.Slot != nil {
+		return *x.Slot
+	}
+	return 0
+}
+
+type LoadStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *LoadStateResponse) Reset() {
+	*x = LoadStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadStateResponse) ProtoMessage() {}
+
+func (x *LoadStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadStateResponse.ProtoReflect.Descriptor instead.
+func (*LoadStateResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LoadStateResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type ScreenshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *ScreenshotRequest) Reset() {
+	*x = ScreenshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreenshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenshotRequest) ProtoMessage() {}
+
+func (x *ScreenshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenshotRequest.ProtoReflect.Descriptor instead.
+func (*ScreenshotRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ScreenshotRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type ScreenshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *ScreenshotResponse) Reset() {
+	*x = ScreenshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreenshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenshotResponse) ProtoMessage() {}
+
+func (x *ScreenshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenshotResponse.ProtoReflect.Descriptor instead.
+func (*ScreenshotResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ScreenshotResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type DevicesResponse_Device struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URI that describes exactly how to connect to the device, e.g.:
+	// RetroArch:  "ra://127.0.0.1:55355"
+	// FX Pak Pro: "fxpakpro://./dev/cu.usbmodemDEMO000000001" (MacOS)
+	//             "fxpakpro://./COM4"                         (Windows)
+	//             "fxpakpro://./dev/ttyACM0"                  (Linux)
+	// uri is used as the unique identifier of the device for clients to refer to
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// friendly display name of the device
+	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	// device kind, e.g. "fxpakpro", "retroarch", "lua"
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// all device capabilities:
+	Capabilities []DeviceCapability `protobuf:"varint,4,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
+	// default address space for the device:
+	DefaultAddressSpace AddressSpace `protobuf:"varint,5,opt,name=defaultAddressSpace,proto3,enum=AddressSpace" json:"defaultAddressSpace,omitempty"`
+	// [DEPRECATED] console system supported, e.g. "snes", "n64"
+	// since devices can support multiple systems, it's better to fetch platform from DeviceInfo.FetchFields method
+	//
+	// Deprecated: Do not use.
+	System string `protobuf:"bytes,6,opt,name=system,proto3" json:"system,omitempty"`
+}
+
+func (x *DevicesResponse_Device) Reset() {
+	*x = DevicesResponse_Device{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DevicesResponse_Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DevicesResponse_Device) ProtoMessage() {}
+
+func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DevicesResponse_Device.ProtoReflect.Descriptor instead.
+func (*DevicesResponse_Device) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *DevicesResponse_Device) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetCapabilities() []DeviceCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *DevicesResponse_Device) GetDefaultAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DefaultAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+// Deprecated: Do not use.
+func (x *DevicesResponse_Device) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+type WatchDevicesResponse_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type WatchDevicesResponse_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=WatchDevicesResponse_EventType" json:"type,omitempty"`
+	// full device details; for Removed events this is the last known state of the device
+	Device *DevicesResponse_Device `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *WatchDevicesResponse_Event) Reset() {
+	*x = WatchDevicesResponse_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchDevicesResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDevicesResponse_Event) ProtoMessage() {}
+
+func (x *WatchDevicesResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDevicesResponse_Event.ProtoReflect.Descriptor instead.
+func (*WatchDevicesResponse_Event) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *WatchDevicesResponse_Event) GetType() WatchDevicesResponse_EventType {
+	if x != nil {
+		return x.Type
+	}
+	return WatchDevicesResponse_Added
+}
+
+func (x *WatchDevicesResponse_Event) GetDevice() *DevicesResponse_Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type NWACommandResponse_NWAASCIIItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item map[string]string `protobuf:"bytes,1,rep,name=item,proto3" json:"item,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NWACommandResponse_NWAASCIIItem) Reset() {
+	*x = NWACommandResponse_NWAASCIIItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACommandResponse_NWAASCIIItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACommandResponse_NWAASCIIItem) ProtoMessage() {}
+
+func (x *NWACommandResponse_NWAASCIIItem) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACommandResponse_NWAASCIIItem.ProtoReflect.Descriptor instead.
+func (*NWACommandResponse_NWAASCIIItem) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{63, 0}
+}
+
+func (x *NWACommandResponse_NWAASCIIItem) GetItem() map[string]string {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_sni_proto protoreflect.FileDescriptor
+
+var file_sni_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x73, 0x6e, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5d, 0x0a, 0x0e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x0f, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x1a, 0xe4, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x06,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x7d, 0x0a, 0x13, 0x41, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x74, 0x6c, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x74, 0x6c, 0x4e, 0x61,
+	0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x14, 0x41, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x18, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x14, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x22, 0x62, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6b,
+	0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64,
+	0x73, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x6d, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x33, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x30, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x10, 0x02, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22,
+	0x27, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x22, 0x27, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x41, 0x0a, 0x15, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x16,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x22, 0x2f, 0x0a, 0x1b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x22, 0x30, 0x0a, 0x1c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x22, 0x25, 0x0a, 0x11, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x26, 0x0a, 0x12, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x22, 0x62, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x25, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x78, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x3f, 0x0a, 0x13, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41,
+	0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x14, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x22, 0xd6, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x49, 0x0a, 0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x48, 0x00, 0x52, 0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
+	0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16,
+	0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6d, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xaf, 0x01, 0x0a, 0x1b, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x34, 0x0a, 0x0d,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30,
+	0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x6f, 0x6d,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xd4, 0x01, 0x0a,
+	0x11, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a,
+	0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xd5, 0x01, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x02, 0x0a, 0x13, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a,
+	0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x59, 0x0a, 0x17, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x5d, 0x0a, 0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x2f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x5b, 0x0a, 0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a,
+	0x19, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a,
+	0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x54,
+	0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
+	0x61, 0x74, 0x61, 0x6c, 0x22, 0x8b, 0x02, 0x0a, 0x1c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x41, 0x6e, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xdd, 0x02, 0x0a, 0x1d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e,
+	0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f,
+	0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x70,
+	0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4e, 0x61, 0x6e, 0x6f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x70, 0x6f,
+	0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x2f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x61, 0x22, 0x71, 0x0a, 0x13, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x2c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x90, 0x01,
+	0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0d,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4d, 0x61, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4d, 0x61, 0x70,
+	0x22, 0xce, 0x01, 0x0a, 0x0b, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x6b, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x4d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4d, 0x61, 0x70, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x3c,
+	0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x08,
+	0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x62, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x3d, 0x0a, 0x15, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x12, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5b, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b,
+	0x0a, 0x0f, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a,
+	0x10, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x4e, 0x57, 0x41, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x09,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72, 0x67, 0x88, 0x01, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72, 0x67, 0x22, 0xac, 0x02,
+	0x0a, 0x12, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x73, 0x63, 0x69, 0x69, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4e, 0x57, 0x41,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x73,
+	0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x88, 0x01,
+	0x01, 0x1a, 0x87, 0x01, 0x0a, 0x0c, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x3e, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74,
+	0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x95, 0x01, 0x0a,
+	0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x77, 0x61, 0x69, 0x74,
+	0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x73, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41,
+	0x53, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x0e, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x0c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a,
+	0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e,
+	0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e,
+	0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f,
+	0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x72, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x69, 0x0a,
+	0x16, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
+	0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x56, 0x0a, 0x17, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x29, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x22, 0x3e, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x56, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x29, 0x0a,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x44, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x46, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x25, 0x0a, 0x11, 0x53, 0x61, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x22, 0x46, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x25, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22,
+	0x25, 0x0a, 0x11, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x26, 0x0a, 0x12, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x2a, 0x33,
+	0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0c,
+	0x0a, 0x08, 0x46, 0x78, 0x50, 0x61, 0x6b, 0x50, 0x72, 0x6f, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x6e, 0x65, 0x73, 0x41, 0x42, 0x75, 0x73, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x61,
+	0x77, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x4c, 0x6f, 0x52, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x48, 0x69, 0x52,
+	0x4f, 0x4d, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x41, 0x31, 0x10, 0x04, 0x12, 0x07, 0x0a,
+	0x03, 0x42, 0x53, 0x58, 0x10, 0x05, 0x2a, 0xbf, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x41, 0x53, 0x4d, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x07, 0x12, 0x0f, 0x0a,
+	0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x10, 0x08, 0x12, 0x11,
+	0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10,
+	0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x10, 0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x10, 0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10,
+	0x0e, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0f, 0x12, 0x0c,
+	0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x10, 0x14, 0x12, 0x0e, 0x0a, 0x0a,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x10, 0x1e, 0x12, 0x14, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x10, 0x1f, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x76, 0x61, 0x6e,
+	0x63, 0x65, 0x10, 0x20, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x10, 0x28, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x10, 0x29, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74,
+	0x10, 0x2a, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53,
+	0x6c, 0x6f, 0x74, 0x10, 0x2b, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x10, 0x2c, 0x2a, 0xcc, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x14, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6f, 0x72,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x15, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6f,
+	0x72, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x10, 0x16, 0x12, 0x0f, 0x0a, 0x0b,
+	0x52, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x28, 0x12, 0x0f, 0x0a,
+	0x0b, 0x52, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x10, 0x29, 0x12, 0x10,
+	0x0a, 0x0c, 0x52, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x2a,
+	0x12, 0x15, 0x0a, 0x11, 0x43, 0x61, 0x72, 0x74, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x10, 0x2b, 0x2a, 0x27, 0x0a, 0x0c, 0x44, 0x69, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x01,
+	0x32, 0xfc, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3f, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x14, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x12, 0x14, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x14, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0xed, 0x03, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x13, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x15, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41, 0x64,
+	0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0xcf, 0x05, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x12, 0x4c, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x12, 0x1b, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x18, 0x2e, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a,
+	0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x64,
+	0x12, 0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x12, 0x13, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x64, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e,
+	0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x6e, 0x64,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x73, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x32, 0x9b, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x40, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x4d, 0x61, 0x6b, 0x65,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07,
+	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08,
+	0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x42, 0x6f, 0x6f,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a,
+	0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0e, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0x44, 0x0a, 0x09, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x57, 0x41, 0x12, 0x37, 0x0a, 0x0a,
+	0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x2e, 0x4e, 0x57, 0x41,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x46, 0x0a, 0x0e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x12, 0x12, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x41, 0x53, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x86, 0x02,
+	0x0a, 0x0e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x3e, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3e, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xb5, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x61, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x61, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x34, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x4c,
+	0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x12, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3f,
+	0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x61, 0x6c, 0x74,
+	0x74, 0x70, 0x6f, 0x2e, 0x73, 0x6e, 0x69, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x6e, 0x69, 0xaa, 0x02, 0x03, 0x53, 0x4e, 0x49, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sni_proto_rawDescOnce sync.Once
+	file_sni_proto_rawDescData = file_sni_proto_rawDesc
+)
+
+func file_sni_proto_rawDescGZIP() []byte {
+	file_sni_proto_rawDescOnce.Do(func() {
+		file_sni_proto_rawDescData = protoimpl.X.CompressGZIP(file_sni_proto_rawDescData)
+	})
+	return file_sni_proto_rawDescData
+}
+
+var file_sni_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_sni_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_sni_proto_goTypes = []interface{}{
+	(AddressSpace)(0),                       // 0: AddressSpace
+	(MemoryMapping)(0),                      // 1: MemoryMapping
+	(DeviceCapability)(0),                   // 2: DeviceCapability
+	(Field)(0),                              // 3: Field
+	(DirEntryType)(0),                       // 4: DirEntryType
+	(WatchDevicesResponse_EventType)(0),     // 5: WatchDevicesResponse.EventType
+	(*DevicesRequest)(nil),                  // 6: DevicesRequest
+	(*DevicesResponse)(nil),                 // 7: DevicesResponse
+	(*AcquireLeaseRequest)(nil),             // 8: AcquireLeaseRequest
+	(*AcquireLeaseResponse)(nil),            // 9: AcquireLeaseResponse
+	(*ReleaseLeaseRequest)(nil),             // 10: ReleaseLeaseRequest
+	(*ReleaseLeaseResponse)(nil),            // 11: ReleaseLeaseResponse
+	(*WatchDevicesRequest)(nil),             // 12: WatchDevicesRequest
+	(*WatchDevicesResponse)(nil),            // 13: WatchDevicesResponse
+	(*ResetSystemRequest)(nil),              // 14: ResetSystemRequest
+	(*ResetSystemResponse)(nil),             // 15: ResetSystemResponse
+	(*ResetToMenuRequest)(nil),              // 16: ResetToMenuRequest
+	(*ResetToMenuResponse)(nil),             // 17: ResetToMenuResponse
+	(*PauseEmulationRequest)(nil),           // 18: PauseEmulationRequest
+	(*PauseEmulationResponse)(nil),          // 19: PauseEmulationResponse
+	(*PauseToggleEmulationRequest)(nil),     // 20: PauseToggleEmulationRequest
+	(*PauseToggleEmulationResponse)(nil),    // 21: PauseToggleEmulationResponse
+	(*PowerCycleRequest)(nil),               // 22: PowerCycleRequest
+	(*PowerCycleResponse)(nil),              // 23: PowerCycleResponse
+	(*SetRealTimeClockRequest)(nil),         // 24: SetRealTimeClockRequest
+	(*SetRealTimeClockResponse)(nil),        // 25: SetRealTimeClockResponse
+	(*FrameAdvanceRequest)(nil),             // 26: FrameAdvanceRequest
+	(*FrameAdvanceResponse)(nil),            // 27: FrameAdvanceResponse
+	(*DetectMemoryMappingRequest)(nil),      // 28: DetectMemoryMappingRequest
+	(*DetectMemoryMappingResponse)(nil),     // 29: DetectMemoryMappingResponse
+	(*ReadMemoryRequest)(nil),               // 30: ReadMemoryRequest
+	(*ReadMemoryResponse)(nil),              // 31: ReadMemoryResponse
+	(*WriteMemoryRequest)(nil),              // 32: WriteMemoryRequest
+	(*WriteMemoryResponse)(nil),             // 33: WriteMemoryResponse
+	(*SingleReadMemoryRequest)(nil),         // 34: SingleReadMemoryRequest
+	(*SingleReadMemoryResponse)(nil),        // 35: SingleReadMemoryResponse
+	(*SingleWriteMemoryRequest)(nil),        // 36: SingleWriteMemoryRequest
+	(*SingleWriteMemoryResponse)(nil),       // 37: SingleWriteMemoryResponse
+	(*MultiReadMemoryRequest)(nil),          // 38: MultiReadMemoryRequest
+	(*MultiReadMemoryResponse)(nil),         // 39: MultiReadMemoryResponse
+	(*MultiWriteMemoryRequest)(nil),         // 40: MultiWriteMemoryRequest
+	(*MultiWriteMemoryResponse)(nil),        // 41: MultiWriteMemoryResponse
+	(*ResponseStatus)(nil),                  // 42: ResponseStatus
+	(*CompareAndWriteMemoryRequest)(nil),    // 43: CompareAndWriteMemoryRequest
+	(*CompareAndWriteMemoryResponse)(nil),   // 44: CompareAndWriteMemoryResponse
+	(*WatchMemoryRequest)(nil),              // 45: WatchMemoryRequest
+	(*WatchMemoryChange)(nil),               // 46: WatchMemoryChange
+	(*WatchMemoryResponse)(nil),             // 47: WatchMemoryResponse
+	(*ReadSymbolsRequest)(nil),              // 48: ReadSymbolsRequest
+	(*SymbolValue)(nil),                     // 49: SymbolValue
+	(*ReadSymbolsResponse)(nil),             // 50: ReadSymbolsResponse
+	(*ReadDirectoryRequest)(nil),            // 51: ReadDirectoryRequest
+	(*DirEntry)(nil),                        // 52: DirEntry
+	(*ReadDirectoryResponse)(nil),           // 53: ReadDirectoryResponse
+	(*MakeDirectoryRequest)(nil),            // 54: MakeDirectoryRequest
+	(*MakeDirectoryResponse)(nil),           // 55: MakeDirectoryResponse
+	(*RemoveFileRequest)(nil),               // 56: RemoveFileRequest
+	(*RemoveFileResponse)(nil),              // 57: RemoveFileResponse
+	(*RenameFileRequest)(nil),               // 58: RenameFileRequest
+	(*RenameFileResponse)(nil),              // 59: RenameFileResponse
+	(*PutFileRequest)(nil),                  // 60: PutFileRequest
+	(*PutFileResponse)(nil),                 // 61: PutFileResponse
+	(*GetFileRequest)(nil),                  // 62: GetFileRequest
+	(*GetFileResponse)(nil),                 // 63: GetFileResponse
+	(*BootFileRequest)(nil),                 // 64: BootFileRequest
+	(*BootFileResponse)(nil),                // 65: BootFileResponse
+	(*FieldsRequest)(nil),                   // 66: FieldsRequest
+	(*FieldsResponse)(nil),                  // 67: FieldsResponse
+	(*NWACommandRequest)(nil),               // 68: NWACommandRequest
+	(*NWACommandResponse)(nil),              // 69: NWACommandResponse
+	(*ExecuteASMRequest)(nil),               // 70: ExecuteASMRequest
+	(*ExecuteASMResponse)(nil),              // 71: ExecuteASMResponse
+	(*SnapshotRegion)(nil),                  // 72: SnapshotRegion
+	(*SnapshotInfo)(nil),                    // 73: SnapshotInfo
+	(*CaptureSnapshotRequest)(nil),          // 74: CaptureSnapshotRequest
+	(*CaptureSnapshotResponse)(nil),         // 75: CaptureSnapshotResponse
+	(*RestoreSnapshotRequest)(nil),          // 76: RestoreSnapshotRequest
+	(*RestoreSnapshotResponse)(nil),         // 77: RestoreSnapshotResponse
+	(*ListSnapshotsRequest)(nil),            // 78: ListSnapshotsRequest
+	(*ListSnapshotsResponse)(nil),           // 79: ListSnapshotsResponse
+	(*DeleteSnapshotRequest)(nil),           // 80: DeleteSnapshotRequest
+	(*DeleteSnapshotResponse)(nil),          // 81: DeleteSnapshotResponse
+	(*SaveStateRequest)(nil),                // 82: SaveStateRequest
+	(*SaveStateResponse)(nil),               // 83: SaveStateResponse
+	(*LoadStateRequest)(nil),                // 84: LoadStateRequest
+	(*LoadStateResponse)(nil),               // 85: LoadStateResponse
+	(*ScreenshotRequest)(nil),               // 86: ScreenshotRequest
+	(*ScreenshotResponse)(nil),              // 87: ScreenshotResponse
+	(*DevicesResponse_Device)(nil),          // 88: DevicesResponse.Device
+	(*WatchDevicesResponse_Event)(nil),      // 89: WatchDevicesResponse.Event
+	(*NWACommandResponse_NWAASCIIItem)(nil), // 90: NWACommandResponse.NWAASCIIItem
+	nil,                                     // 91: NWACommandResponse.NWAASCIIItem.ItemEntry
+}
+var file_sni_proto_depIdxs = []int32{
+	2,  // 0: DevicesRequest.capabilities:type_name -> DeviceCapability
+	88, // 1: DevicesResponse.devices:type_name -> DevicesResponse.Device
+	2,  // 2: WatchDevicesRequest.capabilities:type_name -> DeviceCapability
+	89, // 3: WatchDevicesResponse.events:type_name -> WatchDevicesResponse.Event
+	1,  // 4: DetectMemoryMappingRequest.fallbackMemoryMapping:type_name -> MemoryMapping
+	1,  // 5: DetectMemoryMappingResponse.memoryMapping:type_name -> MemoryMapping
+	0,  // 6: ReadMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,  // 7: ReadMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 8: ReadMemoryResponse.requestAddressSpace:type_name -> AddressSpace
+	1,  // 9: ReadMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 10: ReadMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
+	0,  // 11: WriteMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,  // 12: WriteMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 13: WriteMemoryResponse.requestAddressSpace:type_name -> AddressSpace
+	1,  // 14: WriteMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 15: WriteMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
+	30, // 16: SingleReadMemoryRequest.request:type_name -> ReadMemoryRequest
+	31, // 17: SingleReadMemoryResponse.response:type_name -> ReadMemoryResponse
+	32, // 18: SingleWriteMemoryRequest.request:type_name -> WriteMemoryRequest
+	33, // 19: SingleWriteMemoryResponse.response:type_name -> WriteMemoryResponse
+	30, // 20: MultiReadMemoryRequest.requests:type_name -> ReadMemoryRequest
+	31, // 21: MultiReadMemoryResponse.responses:type_name -> ReadMemoryResponse
+	42, // 22: MultiReadMemoryResponse.status:type_name -> ResponseStatus
+	32, // 23: MultiWriteMemoryRequest.requests:type_name -> WriteMemoryRequest
+	33, // 24: MultiWriteMemoryResponse.responses:type_name -> WriteMemoryResponse
+	42, // 25: MultiWriteMemoryResponse.status:type_name -> ResponseStatus
+	0,  // 26: CompareAndWriteMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,  // 27: CompareAndWriteMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 28: CompareAndWriteMemoryResponse.requestAddressSpace:type_name -> AddressSpace
+	1,  // 29: CompareAndWriteMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 30: CompareAndWriteMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
+	30, // 31: WatchMemoryRequest.requests:type_name -> ReadMemoryRequest
+	31, // 32: WatchMemoryChange.response:type_name -> ReadMemoryResponse
+	46, // 33: WatchMemoryResponse.changes:type_name -> WatchMemoryChange
+	1,  // 34: ReadSymbolsRequest.memoryMapping:type_name -> MemoryMapping
+	0,  // 35: SymbolValue.addressSpace:type_name -> AddressSpace
+	49, // 36: ReadSymbolsResponse.values:type_name -> SymbolValue
+	4,  // 37: DirEntry.type:type_name -> DirEntryType
+	52, // 38: ReadDirectoryResponse.entries:type_name -> DirEntry
+	3,  // 39: FieldsRequest.fields:type_name -> Field
+	3,  // 40: FieldsResponse.fields:type_name -> Field
+	90, // 41: NWACommandResponse.asciiReply:type_name -> NWACommandResponse.NWAASCIIItem
+	30, // 42: ExecuteASMRequest.results:type_name -> ReadMemoryRequest
+	31, // 43: ExecuteASMResponse.results:type_name -> ReadMemoryResponse
+	72, // 44: SnapshotInfo.regions:type_name -> SnapshotRegion
+	72, // 45: CaptureSnapshotRequest.regions:type_name -> SnapshotRegion
+	73, // 46: CaptureSnapshotResponse.snapshot:type_name -> SnapshotInfo
+	73, // 47: RestoreSnapshotResponse.snapshot:type_name -> SnapshotInfo
+	73, // 48: ListSnapshotsResponse.snapshots:type_name -> SnapshotInfo
+	2,  // 49: DevicesResponse.Device.capabilities:type_name -> DeviceCapability
+	0,  // 50: DevicesResponse.Device.defaultAddressSpace:type_name -> AddressSpace
+	5,  // 51: WatchDevicesResponse.Event.type:type_name -> WatchDevicesResponse.EventType
+	88, // 52: WatchDevicesResponse.Event.device:type_name -> DevicesResponse.Device
+	91, // 53: NWACommandResponse.NWAASCIIItem.item:type_name -> NWACommandResponse.NWAASCIIItem.ItemEntry
+	6,  // 54: Devices.ListDevices:input_type -> DevicesRequest
+	12, // 55: Devices.WatchDevices:input_type -> WatchDevicesRequest
+	8,  // 56: Devices.AcquireLease:input_type -> AcquireLeaseRequest
+	10, // 57: Devices.ReleaseLease:input_type -> ReleaseLeaseRequest
+	14, // 58: DeviceControl.ResetSystem:input_type -> ResetSystemRequest
+	16, // 59: DeviceControl.ResetToMenu:input_type -> ResetToMenuRequest
+	18, // 60: DeviceControl.PauseUnpauseEmulation:input_type -> PauseEmulationRequest
+	20, // 61: DeviceControl.PauseToggleEmulation:input_type -> PauseToggleEmulationRequest
+	22, // 62: DeviceControl.PowerCycle:input_type -> PowerCycleRequest
+	24, // 63: DeviceControl.SetRealTimeClock:input_type -> SetRealTimeClockRequest
+	26, // 64: DeviceControl.FrameAdvance:input_type -> FrameAdvanceRequest
+	28, // 65: DeviceMemory.MappingDetect:input_type -> DetectMemoryMappingRequest
+	34, // 66: DeviceMemory.SingleRead:input_type -> SingleReadMemoryRequest
+	36, // 67: DeviceMemory.SingleWrite:input_type -> SingleWriteMemoryRequest
+	38, // 68: DeviceMemory.MultiRead:input_type -> MultiReadMemoryRequest
+	40, // 69: DeviceMemory.MultiWrite:input_type -> MultiWriteMemoryRequest
+	38, // 70: DeviceMemory.StreamRead:input_type -> MultiReadMemoryRequest
+	40, // 71: DeviceMemory.StreamWrite:input_type -> MultiWriteMemoryRequest
+	45, // 72: DeviceMemory.WatchMemory:input_type -> WatchMemoryRequest
+	43, // 73: DeviceMemory.CompareAndWrite:input_type -> CompareAndWriteMemoryRequest
+	48, // 74: DeviceMemory.ReadSymbols:input_type -> ReadSymbolsRequest
+	51, // 75: DeviceFilesystem.ReadDirectory:input_type -> ReadDirectoryRequest
+	54, // 76: DeviceFilesystem.MakeDirectory:input_type -> MakeDirectoryRequest
+	56, // 77: DeviceFilesystem.RemoveFile:input_type -> RemoveFileRequest
+	58, // 78: DeviceFilesystem.RenameFile:input_type -> RenameFileRequest
+	60, // 79: DeviceFilesystem.PutFile:input_type -> PutFileRequest
+	62, // 80: DeviceFilesystem.GetFile:input_type -> GetFileRequest
+	64, // 81: DeviceFilesystem.BootFile:input_type -> BootFileRequest
+	66, // 82: DeviceInfo.FetchFields:input_type -> FieldsRequest
+	68, // 83: DeviceNWA.NWACommand:input_type -> NWACommandRequest
+	70, // 84: DeviceAssembly.Execute:input_type -> ExecuteASMRequest
+	74, // 85: DeviceSnapshot.Capture:input_type -> CaptureSnapshotRequest
+	76, // 86: DeviceSnapshot.Restore:input_type -> RestoreSnapshotRequest
+	78, // 87: DeviceSnapshot.List:input_type -> ListSnapshotsRequest
+	80, // 88: DeviceSnapshot.Delete:input_type -> DeleteSnapshotRequest
+	82, // 89: DeviceEmulator.SaveState:input_type -> SaveStateRequest
+	84, // 90: DeviceEmulator.LoadState:input_type -> LoadStateRequest
+	86, // 91: DeviceEmulator.Screenshot:input_type -> ScreenshotRequest
+	7,  // 92: Devices.ListDevices:output_type -> DevicesResponse
+	13, // 93: Devices.WatchDevices:output_type -> WatchDevicesResponse
+	9,  // 94: Devices.AcquireLease:output_type -> AcquireLeaseResponse
+	11, // 95: Devices.ReleaseLease:output_type -> ReleaseLeaseResponse
+	15, // 96: DeviceControl.ResetSystem:output_type -> ResetSystemResponse
+	17, // 97: DeviceControl.ResetToMenu:output_type -> ResetToMenuResponse
+	19, // 98: DeviceControl.PauseUnpauseEmulation:output_type -> PauseEmulationResponse
+	21, // 99: DeviceControl.PauseToggleEmulation:output_type -> PauseToggleEmulationResponse
+	23, // 100: DeviceControl.PowerCycle:output_type -> PowerCycleResponse
+	25, // 101: DeviceControl.SetRealTimeClock:output_type -> SetRealTimeClockResponse
+	27, // 102: DeviceControl.FrameAdvance:output_type -> FrameAdvanceResponse
+	29, // 103: DeviceMemory.MappingDetect:output_type -> DetectMemoryMappingResponse
+	35, // 104: DeviceMemory.SingleRead:output_type -> SingleReadMemoryResponse
+	37, // 105: DeviceMemory.SingleWrite:output_type -> SingleWriteMemoryResponse
+	39, // 106: DeviceMemory.MultiRead:output_type -> MultiReadMemoryResponse
+	41, // 107: DeviceMemory.MultiWrite:output_type -> MultiWriteMemoryResponse
+	39, // 108: DeviceMemory.StreamRead:output_type -> MultiReadMemoryResponse
+	41, // 109: DeviceMemory.StreamWrite:output_type -> MultiWriteMemoryResponse
+	47, // 110: DeviceMemory.WatchMemory:output_type -> WatchMemoryResponse
+	44, // 111: DeviceMemory.CompareAndWrite:output_type -> CompareAndWriteMemoryResponse
+	50, // 112: DeviceMemory.ReadSymbols:output_type -> ReadSymbolsResponse
+	53, // 113: DeviceFilesystem.ReadDirectory:output_type -> ReadDirectoryResponse
+	55, // 114: DeviceFilesystem.MakeDirectory:output_type -> MakeDirectoryResponse
+	57, // 115: DeviceFilesystem.RemoveFile:output_type -> RemoveFileResponse
+	59, // 116: DeviceFilesystem.RenameFile:output_type -> RenameFileResponse
+	61, // 117: DeviceFilesystem.PutFile:output_type -> PutFileResponse
+	63, // 118: DeviceFilesystem.GetFile:output_type -> GetFileResponse
+	65, // 119: DeviceFilesystem.BootFile:output_type -> BootFileResponse
+	67, // 120: DeviceInfo.FetchFields:output_type -> FieldsResponse
+	69, // 121: DeviceNWA.NWACommand:output_type -> NWACommandResponse
+	71, // 122: DeviceAssembly.Execute:output_type -> ExecuteASMResponse
+	75, // 123: DeviceSnapshot.Capture:output_type -> CaptureSnapshotResponse
+	77, // 124: DeviceSnapshot.Restore:output_type -> RestoreSnapshotResponse
+	79, // 125: DeviceSnapshot.List:output_type -> ListSnapshotsResponse
+	81, // 126: DeviceSnapshot.Delete:output_type -> DeleteSnapshotResponse
+	83, // 127: DeviceEmulator.SaveState:output_type -> SaveStateResponse
+	85, // 128: DeviceEmulator.LoadState:output_type -> LoadStateResponse
+	87, // 129: DeviceEmulator.Screenshot:output_type -> ScreenshotResponse
+	92, // [92:130] is the sub-list for method output_type
+	54, // [54:92] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
+}
+
+func init() { file_sni_proto_init() }
+func file_sni_proto_init() {
+	if File_sni_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sni_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetSystemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetSystemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetToMenuRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetToMenuResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseEmulationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseEmulationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseToggleEmulationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseToggleEmulationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerCycleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerCycleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRealTimeClockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRealTimeClockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameAdvanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameAdvanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectMemoryMappingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectMemoryMappingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleReadMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleReadMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleWriteMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleWriteMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiReadMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiReadMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWriteMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWriteMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareAndWriteMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3610,8 +6999,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevicesResponse); i {
+		file_sni_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareAndWriteMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3622,8 +7011,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetSystemRequest); i {
+		file_sni_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3634,8 +7023,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetSystemResponse); i {
+		file_sni_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMemoryChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3646,8 +7035,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetToMenuRequest); i {
+		file_sni_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3658,8 +7047,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetToMenuResponse); i {
+		file_sni_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadSymbolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3670,8 +7059,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseEmulationRequest); i {
+		file_sni_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3682,8 +7071,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseEmulationResponse); i {
+		file_sni_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadSymbolsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3694,8 +7083,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseToggleEmulationRequest); i {
+		file_sni_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDirectoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3706,8 +7095,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseToggleEmulationResponse); i {
+		file_sni_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3718,8 +7107,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectMemoryMappingRequest); i {
+		file_sni_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDirectoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3730,8 +7119,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectMemoryMappingResponse); i {
+		file_sni_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakeDirectoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3742,8 +7131,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadMemoryRequest); i {
+		file_sni_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakeDirectoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3754,8 +7143,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadMemoryResponse); i {
+		file_sni_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3766,8 +7155,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteMemoryRequest); i {
+		file_sni_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3778,8 +7167,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteMemoryResponse); i {
+		file_sni_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3790,8 +7179,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleReadMemoryRequest); i {
+		file_sni_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3802,8 +7191,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleReadMemoryResponse); i {
+		file_sni_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3814,8 +7203,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleWriteMemoryRequest); i {
+		file_sni_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3826,8 +7215,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleWriteMemoryResponse); i {
+		file_sni_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3838,8 +7227,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiReadMemoryRequest); i {
+		file_sni_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3850,8 +7239,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiReadMemoryResponse); i {
+		file_sni_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3862,8 +7251,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiWriteMemoryRequest); i {
+		file_sni_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3874,8 +7263,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiWriteMemoryResponse); i {
+		file_sni_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3886,8 +7275,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadDirectoryRequest); i {
+		file_sni_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3898,8 +7287,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirEntry); i {
+		file_sni_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACommandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3910,8 +7299,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadDirectoryResponse); i {
+		file_sni_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACommandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3922,8 +7311,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakeDirectoryRequest); i {
+		file_sni_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteASMRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3934,8 +7323,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakeDirectoryResponse); i {
+		file_sni_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteASMResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3946,8 +7335,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveFileRequest); i {
+		file_sni_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotRegion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3958,8 +7347,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveFileResponse); i {
+		file_sni_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3970,8 +7359,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameFileRequest); i {
+		file_sni_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptureSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3982,8 +7371,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameFileResponse); i {
+		file_sni_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptureSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3994,8 +7383,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutFileRequest); i {
+		file_sni_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4006,8 +7395,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutFileResponse); i {
+		file_sni_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4018,8 +7407,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileRequest); i {
+		file_sni_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4030,8 +7419,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileResponse); i {
+		file_sni_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4042,8 +7431,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootFileRequest); i {
+		file_sni_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4054,8 +7443,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootFileResponse); i {
+		file_sni_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4066,8 +7455,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldsRequest); i {
+		file_sni_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4078,8 +7467,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldsResponse); i {
+		file_sni_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4090,8 +7479,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NWACommandRequest); i {
+		file_sni_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4102,8 +7491,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NWACommandResponse); i {
+		file_sni_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4114,7 +7503,31 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DevicesResponse_Device); i {
 			case 0:
 				return &v.state
@@ -4126,7 +7539,19 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDevicesResponse_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NWACommandResponse_NWAASCIIItem); i {
 			case 0:
 				return &v.state
@@ -4139,18 +7564,21 @@ func file_sni_proto_init() {
 			}
 		}
 	}
-	file_sni_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_sni_proto_msgTypes[41].OneofWrappers = []interface{}{}
-	file_sni_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[76].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[78].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sni_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   46,
+			NumEnums:      6,
+			NumMessages:   86,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   9,
 		},
 		GoTypes:           file_sni_proto_goTypes,
 		DependencyIndexes: file_sni_proto_depIdxs,
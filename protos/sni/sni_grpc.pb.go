@@ -24,6 +24,13 @@ const _ = grpc.SupportPackageIsVersion7
 type DevicesClient interface {
 	// detect and list devices currently connected to the system:
 	ListDevices(ctx context.Context, in *DevicesRequest, opts ...grpc.CallOption) (*DevicesResponse, error)
+	// watch for devices being added, removed, or changed; detection is shared among all watching clients:
+	WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (Devices_WatchDevicesClient, error)
+	// acquire (or renew) exclusive write access to a device; while held, writes from other clients fail with ABORTED.
+	// present the returned leaseId in the "sni-lease-id" request metadata header on subsequent calls.
+	AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseResponse, error)
+	// release a lease acquired by AcquireLease:
+	ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error)
 }
 
 type devicesClient struct {
@@ -43,12 +50,69 @@ func (c *devicesClient) ListDevices(ctx context.Context, in *DevicesRequest, opt
 	return out, nil
 }
 
+func (c *devicesClient) WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (Devices_WatchDevicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Devices_ServiceDesc.Streams[0], "/Devices/WatchDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &devicesWatchDevicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Devices_WatchDevicesClient interface {
+	Recv() (*WatchDevicesResponse, error)
+	grpc.ClientStream
+}
+
+type devicesWatchDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *devicesWatchDevicesClient) Recv() (*WatchDevicesResponse, error) {
+	m := new(WatchDevicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *devicesClient) AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseResponse, error) {
+	out := new(AcquireLeaseResponse)
+	err := c.cc.Invoke(ctx, "/Devices/AcquireLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devicesClient) ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error) {
+	out := new(ReleaseLeaseResponse)
+	err := c.cc.Invoke(ctx, "/Devices/ReleaseLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DevicesServer is the server API for Devices service.
 // All implementations must embed UnimplementedDevicesServer
 // for forward compatibility
 type DevicesServer interface {
 	// detect and list devices currently connected to the system:
 	ListDevices(context.Context, *DevicesRequest) (*DevicesResponse, error)
+	// watch for devices being added, removed, or changed; detection is shared among all watching clients:
+	WatchDevices(*WatchDevicesRequest, Devices_WatchDevicesServer) error
+	// acquire (or renew) exclusive write access to a device; while held, writes from other clients fail with ABORTED.
+	// present the returned leaseId in the "sni-lease-id" request metadata header on subsequent calls.
+	AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseResponse, error)
+	// release a lease acquired by AcquireLease:
+	ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseResponse, error)
 	mustEmbedUnimplementedDevicesServer()
 }
 
@@ -59,6 +123,15 @@ type UnimplementedDevicesServer struct {
 func (UnimplementedDevicesServer) ListDevices(context.Context, *DevicesRequest) (*DevicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
 }
+func (UnimplementedDevicesServer) WatchDevices(*WatchDevicesRequest, Devices_WatchDevicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDevices not implemented")
+}
+func (UnimplementedDevicesServer) AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLease not implemented")
+}
+func (UnimplementedDevicesServer) ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLease not implemented")
+}
 func (UnimplementedDevicesServer) mustEmbedUnimplementedDevicesServer() {}
 
 // UnsafeDevicesServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +163,63 @@ func _Devices_ListDevices_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Devices_WatchDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDevicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DevicesServer).WatchDevices(m, &devicesWatchDevicesServer{stream})
+}
+
+type Devices_WatchDevicesServer interface {
+	Send(*WatchDevicesResponse) error
+	grpc.ServerStream
+}
+
+type devicesWatchDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *devicesWatchDevicesServer) Send(m *WatchDevicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Devices_AcquireLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).AcquireLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Devices/AcquireLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).AcquireLease(ctx, req.(*AcquireLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Devices_ReleaseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).ReleaseLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Devices/ReleaseLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).ReleaseLease(ctx, req.(*ReleaseLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Devices_ServiceDesc is the grpc.ServiceDesc for Devices service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,8 +231,22 @@ var Devices_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDevices",
 			Handler:    _Devices_ListDevices_Handler,
 		},
+		{
+			MethodName: "AcquireLease",
+			Handler:    _Devices_AcquireLease_Handler,
+		},
+		{
+			MethodName: "ReleaseLease",
+			Handler:    _Devices_ReleaseLease_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDevices",
+			Handler:       _Devices_WatchDevices_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
 }
 
@@ -118,6 +262,12 @@ type DeviceControlClient interface {
 	PauseUnpauseEmulation(ctx context.Context, in *PauseEmulationRequest, opts ...grpc.CallOption) (*PauseEmulationResponse, error)
 	// only available if DeviceCapability PauseToggleEmulation is present
 	PauseToggleEmulation(ctx context.Context, in *PauseToggleEmulationRequest, opts ...grpc.CallOption) (*PauseToggleEmulationResponse, error)
+	// only available if DeviceCapability PowerCycle is present
+	PowerCycle(ctx context.Context, in *PowerCycleRequest, opts ...grpc.CallOption) (*PowerCycleResponse, error)
+	// only available if DeviceCapability SetRealTimeClock is present
+	SetRealTimeClock(ctx context.Context, in *SetRealTimeClockRequest, opts ...grpc.CallOption) (*SetRealTimeClockResponse, error)
+	// only available if DeviceCapability FrameAdvance is present
+	FrameAdvance(ctx context.Context, in *FrameAdvanceRequest, opts ...grpc.CallOption) (*FrameAdvanceResponse, error)
 }
 
 type deviceControlClient struct {
@@ -164,6 +314,33 @@ func (c *deviceControlClient) PauseToggleEmulation(ctx context.Context, in *Paus
 	return out, nil
 }
 
+func (c *deviceControlClient) PowerCycle(ctx context.Context, in *PowerCycleRequest, opts ...grpc.CallOption) (*PowerCycleResponse, error) {
+	out := new(PowerCycleResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/PowerCycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceControlClient) SetRealTimeClock(ctx context.Context, in *SetRealTimeClockRequest, opts ...grpc.CallOption) (*SetRealTimeClockResponse, error) {
+	out := new(SetRealTimeClockResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/SetRealTimeClock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceControlClient) FrameAdvance(ctx context.Context, in *FrameAdvanceRequest, opts ...grpc.CallOption) (*FrameAdvanceResponse, error) {
+	out := new(FrameAdvanceResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/FrameAdvance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceControlServer is the server API for DeviceControl service.
 // All implementations must embed UnimplementedDeviceControlServer
 // for forward compatibility
@@ -176,6 +353,12 @@ type DeviceControlServer interface {
 	PauseUnpauseEmulation(context.Context, *PauseEmulationRequest) (*PauseEmulationResponse, error)
 	// only available if DeviceCapability PauseToggleEmulation is present
 	PauseToggleEmulation(context.Context, *PauseToggleEmulationRequest) (*PauseToggleEmulationResponse, error)
+	// only available if DeviceCapability PowerCycle is present
+	PowerCycle(context.Context, *PowerCycleRequest) (*PowerCycleResponse, error)
+	// only available if DeviceCapability SetRealTimeClock is present
+	SetRealTimeClock(context.Context, *SetRealTimeClockRequest) (*SetRealTimeClockResponse, error)
+	// only available if DeviceCapability FrameAdvance is present
+	FrameAdvance(context.Context, *FrameAdvanceRequest) (*FrameAdvanceResponse, error)
 	mustEmbedUnimplementedDeviceControlServer()
 }
 
@@ -195,6 +378,15 @@ func (UnimplementedDeviceControlServer) PauseUnpauseEmulation(context.Context, *
 func (UnimplementedDeviceControlServer) PauseToggleEmulation(context.Context, *PauseToggleEmulationRequest) (*PauseToggleEmulationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PauseToggleEmulation not implemented")
 }
+func (UnimplementedDeviceControlServer) PowerCycle(context.Context, *PowerCycleRequest) (*PowerCycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerCycle not implemented")
+}
+func (UnimplementedDeviceControlServer) SetRealTimeClock(context.Context, *SetRealTimeClockRequest) (*SetRealTimeClockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRealTimeClock not implemented")
+}
+func (UnimplementedDeviceControlServer) FrameAdvance(context.Context, *FrameAdvanceRequest) (*FrameAdvanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrameAdvance not implemented")
+}
 func (UnimplementedDeviceControlServer) mustEmbedUnimplementedDeviceControlServer() {}
 
 // UnsafeDeviceControlServer may be embedded to opt out of forward compatibility for this service.
@@ -280,6 +472,60 @@ func _DeviceControl_PauseToggleEmulation_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceControl_PowerCycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerCycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).PowerCycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/PowerCycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).PowerCycle(ctx, req.(*PowerCycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceControl_SetRealTimeClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRealTimeClockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).SetRealTimeClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/SetRealTimeClock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).SetRealTimeClock(ctx, req.(*SetRealTimeClockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceControl_FrameAdvance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FrameAdvanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).FrameAdvance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/FrameAdvance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).FrameAdvance(ctx, req.(*FrameAdvanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceControl_ServiceDesc is the grpc.ServiceDesc for DeviceControl service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -303,6 +549,18 @@ var DeviceControl_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PauseToggleEmulation",
 			Handler:    _DeviceControl_PauseToggleEmulation_Handler,
 		},
+		{
+			MethodName: "PowerCycle",
+			Handler:    _DeviceControl_PowerCycle_Handler,
+		},
+		{
+			MethodName: "SetRealTimeClock",
+			Handler:    _DeviceControl_SetRealTimeClock_Handler,
+		},
+		{
+			MethodName: "FrameAdvance",
+			Handler:    _DeviceControl_FrameAdvance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
@@ -326,6 +584,12 @@ type DeviceMemoryClient interface {
 	StreamRead(ctx context.Context, opts ...grpc.CallOption) (DeviceMemory_StreamReadClient, error)
 	// stream write multiple memory segments with given data to the given device:
 	StreamWrite(ctx context.Context, opts ...grpc.CallOption) (DeviceMemory_StreamWriteClient, error)
+	// watch multiple memory segments by polling the given device and stream back only the segments that changed:
+	WatchMemory(ctx context.Context, in *WatchMemoryRequest, opts ...grpc.CallOption) (DeviceMemory_WatchMemoryClient, error)
+	// write data to a memory segment only if it currently contains the expected compare data:
+	CompareAndWrite(ctx context.Context, in *CompareAndWriteMemoryRequest, opts ...grpc.CallOption) (*CompareAndWriteMemoryResponse, error)
+	// read named symbols from the symbol map of the game loaded on the given device:
+	ReadSymbols(ctx context.Context, in *ReadSymbolsRequest, opts ...grpc.CallOption) (*ReadSymbolsResponse, error)
 }
 
 type deviceMemoryClient struct {
@@ -443,6 +707,56 @@ func (x *deviceMemoryStreamWriteClient) Recv() (*MultiWriteMemoryResponse, error
 	return m, nil
 }
 
+func (c *deviceMemoryClient) WatchMemory(ctx context.Context, in *WatchMemoryRequest, opts ...grpc.CallOption) (DeviceMemory_WatchMemoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceMemory_ServiceDesc.Streams[2], "/DeviceMemory/WatchMemory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceMemoryWatchMemoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceMemory_WatchMemoryClient interface {
+	Recv() (*WatchMemoryResponse, error)
+	grpc.ClientStream
+}
+
+type deviceMemoryWatchMemoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceMemoryWatchMemoryClient) Recv() (*WatchMemoryResponse, error) {
+	m := new(WatchMemoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceMemoryClient) CompareAndWrite(ctx context.Context, in *CompareAndWriteMemoryRequest, opts ...grpc.CallOption) (*CompareAndWriteMemoryResponse, error) {
+	out := new(CompareAndWriteMemoryResponse)
+	err := c.cc.Invoke(ctx, "/DeviceMemory/CompareAndWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMemoryClient) ReadSymbols(ctx context.Context, in *ReadSymbolsRequest, opts ...grpc.CallOption) (*ReadSymbolsResponse, error) {
+	out := new(ReadSymbolsResponse)
+	err := c.cc.Invoke(ctx, "/DeviceMemory/ReadSymbols", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceMemoryServer is the server API for DeviceMemory service.
 // All implementations must embed UnimplementedDeviceMemoryServer
 // for forward compatibility
@@ -461,6 +775,12 @@ type DeviceMemoryServer interface {
 	StreamRead(DeviceMemory_StreamReadServer) error
 	// stream write multiple memory segments with given data to the given device:
 	StreamWrite(DeviceMemory_StreamWriteServer) error
+	// watch multiple memory segments by polling the given device and stream back only the segments that changed:
+	WatchMemory(*WatchMemoryRequest, DeviceMemory_WatchMemoryServer) error
+	// write data to a memory segment only if it currently contains the expected compare data:
+	CompareAndWrite(context.Context, *CompareAndWriteMemoryRequest) (*CompareAndWriteMemoryResponse, error)
+	// read named symbols from the symbol map of the game loaded on the given device:
+	ReadSymbols(context.Context, *ReadSymbolsRequest) (*ReadSymbolsResponse, error)
 	mustEmbedUnimplementedDeviceMemoryServer()
 }
 
@@ -489,6 +809,15 @@ func (UnimplementedDeviceMemoryServer) StreamRead(DeviceMemory_StreamReadServer)
 func (UnimplementedDeviceMemoryServer) StreamWrite(DeviceMemory_StreamWriteServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamWrite not implemented")
 }
+func (UnimplementedDeviceMemoryServer) WatchMemory(*WatchMemoryRequest, DeviceMemory_WatchMemoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMemory not implemented")
+}
+func (UnimplementedDeviceMemoryServer) CompareAndWrite(context.Context, *CompareAndWriteMemoryRequest) (*CompareAndWriteMemoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndWrite not implemented")
+}
+func (UnimplementedDeviceMemoryServer) ReadSymbols(context.Context, *ReadSymbolsRequest) (*ReadSymbolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSymbols not implemented")
+}
 func (UnimplementedDeviceMemoryServer) mustEmbedUnimplementedDeviceMemoryServer() {}
 
 // UnsafeDeviceMemoryServer may be embedded to opt out of forward compatibility for this service.
@@ -644,6 +973,63 @@ func (x *deviceMemoryStreamWriteServer) Recv() (*MultiWriteMemoryRequest, error)
 	return m, nil
 }
 
+func _DeviceMemory_WatchMemory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMemoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceMemoryServer).WatchMemory(m, &deviceMemoryWatchMemoryServer{stream})
+}
+
+type DeviceMemory_WatchMemoryServer interface {
+	Send(*WatchMemoryResponse) error
+	grpc.ServerStream
+}
+
+type deviceMemoryWatchMemoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceMemoryWatchMemoryServer) Send(m *WatchMemoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeviceMemory_CompareAndWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndWriteMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMemoryServer).CompareAndWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceMemory/CompareAndWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMemoryServer).CompareAndWrite(ctx, req.(*CompareAndWriteMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMemory_ReadSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMemoryServer).ReadSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceMemory/ReadSymbols",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMemoryServer).ReadSymbols(ctx, req.(*ReadSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceMemory_ServiceDesc is the grpc.ServiceDesc for DeviceMemory service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -671,6 +1057,14 @@ var DeviceMemory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultiWrite",
 			Handler:    _DeviceMemory_MultiWrite_Handler,
 		},
+		{
+			MethodName: "CompareAndWrite",
+			Handler:    _DeviceMemory_CompareAndWrite_Handler,
+		},
+		{
+			MethodName: "ReadSymbols",
+			Handler:    _DeviceMemory_ReadSymbols_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -685,6 +1079,11 @@ var DeviceMemory_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchMemory",
+			Handler:       _DeviceMemory_WatchMemory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "sni.proto",
 }
@@ -1162,3 +1561,457 @@ var DeviceNWA_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
 }
+
+// DeviceAssemblyClient is the client API for DeviceAssembly service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceAssemblyClient interface {
+	// only available if DeviceCapability ExecuteASM is present
+	Execute(ctx context.Context, in *ExecuteASMRequest, opts ...grpc.CallOption) (*ExecuteASMResponse, error)
+}
+
+type deviceAssemblyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceAssemblyClient(cc grpc.ClientConnInterface) DeviceAssemblyClient {
+	return &deviceAssemblyClient{cc}
+}
+
+func (c *deviceAssemblyClient) Execute(ctx context.Context, in *ExecuteASMRequest, opts ...grpc.CallOption) (*ExecuteASMResponse, error) {
+	out := new(ExecuteASMResponse)
+	err := c.cc.Invoke(ctx, "/DeviceAssembly/Execute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceAssemblyServer is the server API for DeviceAssembly service.
+// All implementations must embed UnimplementedDeviceAssemblyServer
+// for forward compatibility
+type DeviceAssemblyServer interface {
+	// only available if DeviceCapability ExecuteASM is present
+	Execute(context.Context, *ExecuteASMRequest) (*ExecuteASMResponse, error)
+	mustEmbedUnimplementedDeviceAssemblyServer()
+}
+
+// UnimplementedDeviceAssemblyServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceAssemblyServer struct {
+}
+
+func (UnimplementedDeviceAssemblyServer) Execute(context.Context, *ExecuteASMRequest) (*ExecuteASMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
+}
+func (UnimplementedDeviceAssemblyServer) mustEmbedUnimplementedDeviceAssemblyServer() {}
+
+// UnsafeDeviceAssemblyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceAssemblyServer will
+// result in compilation errors.
+type UnsafeDeviceAssemblyServer interface {
+	mustEmbedUnimplementedDeviceAssemblyServer()
+}
+
+func RegisterDeviceAssemblyServer(s grpc.ServiceRegistrar, srv DeviceAssemblyServer) {
+	s.RegisterService(&DeviceAssembly_ServiceDesc, srv)
+}
+
+func _DeviceAssembly_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteASMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceAssemblyServer).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceAssembly/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceAssemblyServer).Execute(ctx, req.(*ExecuteASMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceAssembly_ServiceDesc is the grpc.ServiceDesc for DeviceAssembly service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceAssembly_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DeviceAssembly",
+	HandlerType: (*DeviceAssemblyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Execute",
+			Handler:    _DeviceAssembly_Execute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sni.proto",
+}
+
+// DeviceSnapshotClient is the client API for DeviceSnapshot service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceSnapshotClient interface {
+	// reads memory regions of a device into a named snapshot stored by SNI; requires ReadMemory and FetchFields
+	Capture(ctx context.Context, in *CaptureSnapshotRequest, opts ...grpc.CallOption) (*CaptureSnapshotResponse, error)
+	// writes a snapshot's memory regions back to a device running the same ROM; requires WriteMemory and FetchFields
+	Restore(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	// lists stored snapshots
+	List(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// deletes a stored snapshot
+	Delete(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error)
+}
+
+type deviceSnapshotClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceSnapshotClient(cc grpc.ClientConnInterface) DeviceSnapshotClient {
+	return &deviceSnapshotClient{cc}
+}
+
+func (c *deviceSnapshotClient) Capture(ctx context.Context, in *CaptureSnapshotRequest, opts ...grpc.CallOption) (*CaptureSnapshotResponse, error) {
+	out := new(CaptureSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/DeviceSnapshot/Capture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSnapshotClient) Restore(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/DeviceSnapshot/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSnapshotClient) List(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/DeviceSnapshot/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSnapshotClient) Delete(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error) {
+	out := new(DeleteSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/DeviceSnapshot/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceSnapshotServer is the server API for DeviceSnapshot service.
+// All implementations must embed UnimplementedDeviceSnapshotServer
+// for forward compatibility
+type DeviceSnapshotServer interface {
+	// reads memory regions of a device into a named snapshot stored by SNI; requires ReadMemory and FetchFields
+	Capture(context.Context, *CaptureSnapshotRequest) (*CaptureSnapshotResponse, error)
+	// writes a snapshot's memory regions back to a device running the same ROM; requires WriteMemory and FetchFields
+	Restore(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	// lists stored snapshots
+	List(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// deletes a stored snapshot
+	Delete(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
+	mustEmbedUnimplementedDeviceSnapshotServer()
+}
+
+// UnimplementedDeviceSnapshotServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceSnapshotServer struct {
+}
+
+func (UnimplementedDeviceSnapshotServer) Capture(context.Context, *CaptureSnapshotRequest) (*CaptureSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capture not implemented")
+}
+func (UnimplementedDeviceSnapshotServer) Restore(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedDeviceSnapshotServer) List(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedDeviceSnapshotServer) Delete(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedDeviceSnapshotServer) mustEmbedUnimplementedDeviceSnapshotServer() {}
+
+// UnsafeDeviceSnapshotServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceSnapshotServer will
+// result in compilation errors.
+type UnsafeDeviceSnapshotServer interface {
+	mustEmbedUnimplementedDeviceSnapshotServer()
+}
+
+func RegisterDeviceSnapshotServer(s grpc.ServiceRegistrar, srv DeviceSnapshotServer) {
+	s.RegisterService(&DeviceSnapshot_ServiceDesc, srv)
+}
+
+func _DeviceSnapshot_Capture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSnapshotServer).Capture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceSnapshot/Capture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSnapshotServer).Capture(ctx, req.(*CaptureSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSnapshot_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSnapshotServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceSnapshot/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSnapshotServer).Restore(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSnapshot_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSnapshotServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceSnapshot/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSnapshotServer).List(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSnapshot_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSnapshotServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceSnapshot/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSnapshotServer).Delete(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceSnapshot_ServiceDesc is the grpc.ServiceDesc for DeviceSnapshot service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceSnapshot_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DeviceSnapshot",
+	HandlerType: (*DeviceSnapshotServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Capture",
+			Handler:    _DeviceSnapshot_Capture_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _DeviceSnapshot_Restore_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DeviceSnapshot_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DeviceSnapshot_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sni.proto",
+}
+
+// DeviceEmulatorClient is the client API for DeviceEmulator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceEmulatorClient interface {
+	// only available if DeviceCapability SaveState is present; a slot requires DeviceCapability SaveStateSlot
+	SaveState(ctx context.Context, in *SaveStateRequest, opts ...grpc.CallOption) (*SaveStateResponse, error)
+	// only available if DeviceCapability LoadState is present; a slot requires DeviceCapability LoadStateSlot
+	LoadState(ctx context.Context, in *LoadStateRequest, opts ...grpc.CallOption) (*LoadStateResponse, error)
+	// only available if DeviceCapability Screenshot is present
+	Screenshot(ctx context.Context, in *ScreenshotRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error)
+}
+
+type deviceEmulatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceEmulatorClient(cc grpc.ClientConnInterface) DeviceEmulatorClient {
+	return &deviceEmulatorClient{cc}
+}
+
+func (c *deviceEmulatorClient) SaveState(ctx context.Context, in *SaveStateRequest, opts ...grpc.CallOption) (*SaveStateResponse, error) {
+	out := new(SaveStateResponse)
+	err := c.cc.Invoke(ctx, "/DeviceEmulator/SaveState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceEmulatorClient) LoadState(ctx context.Context, in *LoadStateRequest, opts ...grpc.CallOption) (*LoadStateResponse, error) {
+	out := new(LoadStateResponse)
+	err := c.cc.Invoke(ctx, "/DeviceEmulator/LoadState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceEmulatorClient) Screenshot(ctx context.Context, in *ScreenshotRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error) {
+	out := new(ScreenshotResponse)
+	err := c.cc.Invoke(ctx, "/DeviceEmulator/Screenshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceEmulatorServer is the server API for DeviceEmulator service.
+// All implementations must embed UnimplementedDeviceEmulatorServer
+// for forward compatibility
+type DeviceEmulatorServer interface {
+	// only available if DeviceCapability SaveState is present; a slot requires DeviceCapability SaveStateSlot
+	SaveState(context.Context, *SaveStateRequest) (*SaveStateResponse, error)
+	// only available if DeviceCapability LoadState is present; a slot requires DeviceCapability LoadStateSlot
+	LoadState(context.Context, *LoadStateRequest) (*LoadStateResponse, error)
+	// only available if DeviceCapability Screenshot is present
+	Screenshot(context.Context, *ScreenshotRequest) (*ScreenshotResponse, error)
+	mustEmbedUnimplementedDeviceEmulatorServer()
+}
+
+// UnimplementedDeviceEmulatorServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceEmulatorServer struct {
+}
+
+func (UnimplementedDeviceEmulatorServer) SaveState(context.Context, *SaveStateRequest) (*SaveStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveState not implemented")
+}
+func (UnimplementedDeviceEmulatorServer) LoadState(context.Context, *LoadStateRequest) (*LoadStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadState not implemented")
+}
+func (UnimplementedDeviceEmulatorServer) Screenshot(context.Context, *ScreenshotRequest) (*ScreenshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Screenshot not implemented")
+}
+func (UnimplementedDeviceEmulatorServer) mustEmbedUnimplementedDeviceEmulatorServer() {}
+
+// UnsafeDeviceEmulatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceEmulatorServer will
+// result in compilation errors.
+type UnsafeDeviceEmulatorServer interface {
+	mustEmbedUnimplementedDeviceEmulatorServer()
+}
+
+func RegisterDeviceEmulatorServer(s grpc.ServiceRegistrar, srv DeviceEmulatorServer) {
+	s.RegisterService(&DeviceEmulator_ServiceDesc, srv)
+}
+
+func _DeviceEmulator_SaveState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceEmulatorServer).SaveState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceEmulator/SaveState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceEmulatorServer).SaveState(ctx, req.(*SaveStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceEmulator_LoadState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceEmulatorServer).LoadState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceEmulator/LoadState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceEmulatorServer).LoadState(ctx, req.(*LoadStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceEmulator_Screenshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceEmulatorServer).Screenshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceEmulator/Screenshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceEmulatorServer).Screenshot(ctx, req.(*ScreenshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceEmulator_ServiceDesc is the grpc.ServiceDesc for DeviceEmulator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceEmulator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DeviceEmulator",
+	HandlerType: (*DeviceEmulatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveState",
+			Handler:    _DeviceEmulator_SaveState_Handler,
+		},
+		{
+			MethodName: "LoadState",
+			Handler:    _DeviceEmulator_LoadState_Handler,
+		},
+		{
+			MethodName: "Screenshot",
+			Handler:    _DeviceEmulator_Screenshot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sni.proto",
+}
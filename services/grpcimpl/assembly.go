@@ -0,0 +1,87 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/protos/sni"
+)
+
+type DeviceAssemblyService struct {
+	sni.UnimplementedDeviceAssemblyServer
+}
+
+func (s *DeviceAssemblyService) Execute(gctx context.Context, request *sni.ExecuteASMRequest) (grsp *sni.ExecuteASMResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(request.GetCode()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "code must not be empty")
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ExecuteASM); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+	if len(request.GetResults()) > 0 {
+		if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+	}
+
+	reads := make([]devices.MemoryReadRequest, 0, len(request.GetResults()))
+	for _, req := range request.GetResults() {
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       req.GetRequestAddress(),
+				AddressSpace:  req.GetRequestAddressSpace(),
+				MemoryMapping: req.GetRequestMemoryMapping(),
+			},
+			Size: int(req.GetSize()),
+		})
+	}
+
+	var completed bool
+	var mrsps []devices.MemoryReadResponse
+	completed, mrsps, gerr = device.ExecuteASM(gctx, request.GetCode(), request.GetWaitForCompletion(), reads...)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+	if actual, expected := len(mrsps), len(reads); actual != expected {
+		gerr = status.Errorf(
+			codes.Internal,
+			"execute must have equal number of results and result requests; actual %d expected %d",
+			actual,
+			expected,
+		)
+		return
+	}
+
+	grsps := make([]*sni.ReadMemoryResponse, 0, len(mrsps))
+	for _, mrsp := range mrsps {
+		grsps = append(grsps, &sni.ReadMemoryResponse{
+			RequestAddress:       mrsp.RequestAddress.Address,
+			RequestAddressSpace:  mrsp.RequestAddress.AddressSpace,
+			RequestMemoryMapping: mrsp.RequestAddress.MemoryMapping,
+			DeviceAddress:        mrsp.DeviceAddress.Address,
+			DeviceAddressSpace:   mrsp.DeviceAddress.AddressSpace,
+			Data:                 mrsp.Data,
+		})
+	}
+
+	grsp = &sni.ExecuteASMResponse{
+		Uri:       request.Uri,
+		Completed: completed,
+		Results:   grsps,
+	}
+	return
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"sni/devices"
 	"sni/protos/sni"
+	"time"
 )
 
 type DeviceControlService struct {
@@ -130,3 +131,101 @@ func (d *DeviceControlService) PauseToggleEmulation(gctx context.Context, reques
 
 	return
 }
+
+func (d *DeviceControlService) PowerCycle(gctx context.Context, request *sni.PowerCycleRequest) (grsp *sni.PowerCycleResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_PowerCycle); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = device.PowerCycle(gctx)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.PowerCycleResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+func (d *DeviceControlService) SetRealTimeClock(gctx context.Context, request *sni.SetRealTimeClockRequest) (grsp *sni.SetRealTimeClockResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_SetRealTimeClock); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	t := time.Now()
+	if request.UnixSeconds != nil {
+		t = time.Unix(request.GetUnixSeconds(), 0)
+	}
+
+	gerr = device.SetRealTimeClock(gctx, t)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.SetRealTimeClockResponse{
+		Uri:         request.Uri,
+		UnixSeconds: t.Unix(),
+	}
+
+	return
+}
+
+func (d *DeviceControlService) FrameAdvance(gctx context.Context, request *sni.FrameAdvanceRequest) (grsp *sni.FrameAdvanceResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_FrameAdvance); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	frames := request.GetFrames()
+	if frames == 0 {
+		frames = 1
+	}
+
+	gerr = device.FrameAdvance(gctx, frames)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.FrameAdvanceResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
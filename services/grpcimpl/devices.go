@@ -4,17 +4,67 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"net/url"
 	"sni/devices"
 	"sni/protos/sni"
+	"strconv"
+	"time"
 )
 
+// grpcError converts device errors to gRPC status errors. The status code is taken from an explicit
+// devices.CodedError if present, otherwise from the error's devices.ErrorKind. An errdetails.ErrorInfo detail
+// carries the error kind as its reason and whether the error was fatal to the device connection.
 func grpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	kind := devices.KindOf(err)
+	var deviceErr devices.DeviceError
+	isDeviceErr := errors.As(err, &deviceErr)
+
+	var code codes.Code
 	var coded *devices.CodedError
 	if errors.As(err, &coded) {
-		return status.Error(coded.Code, coded.Error())
+		code = coded.Code
+	} else if kind != devices.ErrorKindUnknown || isDeviceErr {
+		code = kind.Code()
+	} else {
+		return err
 	}
-	return err
+
+	fatal := devices.IsFatal(err)
+	st, derr := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: kind.String(),
+		Domain: errorInfoDomain,
+		Metadata: map[string]string{
+			"fatal": strconv.FormatBool(fatal),
+		},
+	})
+	if derr != nil {
+		return status.Error(code, err.Error())
+	}
+	return st.Err()
+}
+
+const errorInfoDomain = "sni"
+
+// isFatalError checks the fatal flag of errors converted by grpcError as well as unconverted device errors
+func isFatalError(err error) bool {
+	if st, ok := status.FromError(err); ok && st.Code() != codes.OK {
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetDomain() == errorInfoDomain {
+				return info.GetMetadata()["fatal"] == "true"
+			}
+		}
+	}
+	return devices.IsFatal(err)
 }
 
 type DevicesService struct {
@@ -32,38 +82,167 @@ func (s *DevicesService) ListDevices(ctx context.Context, request *sni.DevicesRe
 		descriptors = append(descriptors, d...)
 	}
 
-	var kindPredicate func(kind string) bool
-	if request.GetKinds() == nil {
-		kindPredicate = func(kind string) bool { return true }
-	} else {
-		kindPredicate = func(kind string) bool {
-			for _, k := range request.GetKinds() {
-				if kind == k {
-					return true
-				}
-			}
-			return false
-		}
-	}
+	kindPredicate := kindPredicateFor(request.GetKinds())
+	capabilities := request.GetCapabilities()
 
 	devs := make([]*sni.DevicesResponse_Device, 0, 10)
 	for _, descriptor := range descriptors {
 		if !kindPredicate(descriptor.Kind) {
 			continue
 		}
+		if ok, _ := devices.CheckCapabilities(capabilities, descriptor.Capabilities); !ok {
+			continue
+		}
 
-		devs = append(devs, &sni.DevicesResponse_Device{
-			Uri:                 descriptor.Uri.String(),
-			DisplayName:         descriptor.DisplayName,
-			Kind:                descriptor.Kind,
-			Capabilities:        descriptor.Capabilities,
-			DefaultAddressSpace: descriptor.DefaultAddressSpace,
-		})
+		devs = append(devs, deviceFromDescriptor(&descriptor))
 	}
 
 	return &sni.DevicesResponse{Devices: devs}, nil
 }
 
+func (s *DevicesService) WatchDevices(request *sni.WatchDevicesRequest, stream sni.Devices_WatchDevicesServer) error {
+	kindPredicate := kindPredicateFor(request.GetKinds())
+	capabilities := request.GetCapabilities()
+
+	events, unsubscribe := devices.Watcher.Subscribe()
+	defer unsubscribe()
+
+	// matched tracks the URIs of the devices this client was told about so that devices which gain or lose a
+	// required capability are reported as added or removed:
+	matched := make(map[string]struct{})
+
+	ctx := stream.Context()
+	first := true
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case batch, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "client not keeping up with device events")
+			}
+
+			gevents := make([]*sni.WatchDevicesResponse_Event, 0, len(batch))
+			for _, event := range batch {
+				uri := event.Descriptor.Uri.String()
+				_, wasMatched := matched[uri]
+				isMatched := event.Type != devices.DeviceRemoved && kindPredicate(event.Descriptor.Kind)
+				if isMatched {
+					isMatched, _ = devices.CheckCapabilities(capabilities, event.Descriptor.Capabilities)
+				}
+
+				eventType := event.Type
+				switch {
+				case isMatched && !wasMatched:
+					eventType = devices.DeviceAdded
+					matched[uri] = struct{}{}
+				case !isMatched && wasMatched:
+					eventType = devices.DeviceRemoved
+					delete(matched, uri)
+				case !isMatched:
+					continue
+				}
+
+				gevents = append(gevents, &sni.WatchDevicesResponse_Event{
+					Type:   sni.WatchDevicesResponse_EventType(eventType),
+					Device: deviceFromDescriptor(&event.Descriptor),
+				})
+			}
+
+			// always send the first batch so clients know the initial detection is complete:
+			if len(gevents) == 0 && !first {
+				continue
+			}
+			first = false
+
+			err := stream.Send(&sni.WatchDevicesResponse{Events: gevents})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *DevicesService) AcquireLease(gctx context.Context, request *sni.AcquireLeaseRequest) (grsp *sni.AcquireLeaseResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var device devices.AutoCloseableDevice
+	_, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var leaseID string
+	var expiresAt time.Time
+	leaseID, expiresAt, gerr = device.AcquireLease(
+		gctx,
+		request.GetLeaseId(),
+		time.Duration(request.GetTtlNanoseconds()),
+		request.GetWait(),
+	)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.AcquireLeaseResponse{
+		Uri:                      request.Uri,
+		LeaseId:                  leaseID,
+		ExpiresAtUnixNanoseconds: expiresAt.UnixNano(),
+	}
+	return
+}
+
+func (s *DevicesService) ReleaseLease(gctx context.Context, request *sni.ReleaseLeaseRequest) (grsp *sni.ReleaseLeaseResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var device devices.AutoCloseableDevice
+	_, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	gerr = device.ReleaseLease(gctx, request.GetLeaseId())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ReleaseLeaseResponse{
+		Uri: request.Uri,
+	}
+	return
+}
+
+func kindPredicateFor(kinds []string) func(kind string) bool {
+	if kinds == nil {
+		return func(kind string) bool { return true }
+	}
+
+	return func(kind string) bool {
+		for _, k := range kinds {
+			if kind == k {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func deviceFromDescriptor(descriptor *devices.DeviceDescriptor) *sni.DevicesResponse_Device {
+	return &sni.DevicesResponse_Device{
+		Uri:                 descriptor.Uri.String(),
+		DisplayName:         descriptor.DisplayName,
+		Kind:                descriptor.Kind,
+		Capabilities:        descriptor.Capabilities,
+		DefaultAddressSpace: descriptor.DefaultAddressSpace,
+	}
+}
+
 func (s *DevicesService) MethodRequestString(method string, req interface{}) string {
 	if req == nil {
 		return "nil"
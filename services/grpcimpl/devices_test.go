@@ -0,0 +1,221 @@
+package grpcimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/drivers/mock"
+	"sni/protos/sni"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var registerOnce sync.Once
+
+// registerDrivers registers the mock driver and a toggleDriver once for all tests
+func registerDrivers() {
+	registerOnce.Do(func() {
+		config.Config.Set("mock_enable", true)
+		mock.DriverInit()
+		devices.Register(toggleKind, toggle)
+	})
+}
+
+const toggleKind = "toggle"
+
+// toggle is the mock driver detecting a single device whose presence and capabilities the test controls
+var toggle = &toggleDriver{Driver: &mock.Driver{}}
+
+type toggleDriver struct {
+	*mock.Driver
+
+	lock         sync.Mutex
+	present      bool
+	capabilities []sni.DeviceCapability
+}
+
+func (d *toggleDriver) Kind() string { return toggleKind }
+
+func (d *toggleDriver) set(present bool, capabilities ...sni.DeviceCapability) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.present, d.capabilities = present, capabilities
+}
+
+func (d *toggleDriver) Detect() ([]devices.DeviceDescriptor, error) {
+	descriptors, err := d.Driver.Detect()
+	if err != nil {
+		return nil, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if !d.present {
+		return nil, nil
+	}
+
+	descriptors[0].Uri = url.URL{Scheme: toggleKind, Opaque: "toggle"}
+	descriptors[0].Kind = toggleKind
+	descriptors[0].Capabilities = d.capabilities
+	return descriptors[:1], nil
+}
+
+// fakeStream passes the messages a server-streaming RPC sends to the test until its context is cancelled
+type fakeStream[T any] struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *T
+}
+
+func newFakeStream[T any](t *testing.T) (*fakeStream[T], context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &fakeStream[T]{ctx: ctx, sent: make(chan *T, 16)}, cancel
+}
+
+func (s *fakeStream[T]) Context() context.Context { return s.ctx }
+
+func (s *fakeStream[T]) Send(m *T) error {
+	s.sent <- m
+	return nil
+}
+
+func (s *fakeStream[T]) receive(t *testing.T) *T {
+	t.Helper()
+
+	select {
+	case m := <-s.sent:
+		return m
+	case <-time.After(time.Second):
+		t.Fatal("nothing sent")
+		return nil
+	}
+}
+
+func TestDevicesService_WatchDevices_capabilities(t *testing.T) {
+	registerDrivers()
+	devices.Watcher = devices.NewDeviceWatcher(time.Millisecond)
+
+	all := []sni.DeviceCapability{sni.DeviceCapability_ReadMemory, sni.DeviceCapability_WriteMemory}
+	toggle.set(true, all...)
+
+	stream, cancel := newFakeStream[sni.WatchDevicesResponse](t)
+	done := make(chan error, 1)
+	go func() {
+		done <- (&DevicesService{}).WatchDevices(&sni.WatchDevicesRequest{
+			Kinds:        []string{toggleKind},
+			Capabilities: all,
+		}, stream)
+	}()
+
+	expect := func(step string, eventType sni.WatchDevicesResponse_EventType) {
+		t.Helper()
+
+		rsp := stream.receive(t)
+		if len(rsp.Events) != 1 || rsp.Events[0].Type != eventType || rsp.Events[0].Device.Uri != "toggle:toggle" {
+			t.Fatalf("%s: events = %v, want one %v", step, rsp.Events, eventType)
+		}
+	}
+
+	expect("initial", sni.WatchDevicesResponse_Added)
+
+	toggle.set(true, sni.DeviceCapability_ReadMemory)
+	expect("lost capability", sni.WatchDevicesResponse_Removed)
+
+	toggle.set(true, append(all, sni.DeviceCapability_ResetSystem)...)
+	expect("regained capability", sni.WatchDevicesResponse_Added)
+
+	toggle.set(true, all...)
+	expect("still matching", sni.WatchDevicesResponse_Changed)
+
+	// a device which does not match is never reported, not even when it goes away:
+	toggle.set(true, sni.DeviceCapability_ReadMemory)
+	expect("lost capability again", sni.WatchDevicesResponse_Removed)
+	toggle.set(false)
+	toggle.set(true, all...)
+	expect("matching again", sni.WatchDevicesResponse_Added)
+
+	toggle.set(false)
+	expect("gone", sni.WatchDevicesResponse_Removed)
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGrpcError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		// wantPassed is whether the error is returned unconverted:
+		wantPassed bool
+		wantCode   codes.Code
+		wantReason string
+		wantFatal  string
+	}{
+		{name: "nil", wantPassed: true},
+		{name: "plain", err: errors.New("plain"), wantPassed: true},
+		{name: "status", err: status.Error(codes.NotFound, "missing"), wantPassed: true},
+		{name: "device error", err: devices.DeviceNonFatal("failed", nil), wantCode: codes.Unknown, wantReason: "UNKNOWN", wantFatal: "false"},
+		{name: "timeout", err: devices.DeviceNonFatal("read", context.DeadlineExceeded), wantCode: codes.DeadlineExceeded, wantReason: "TIMEOUT", wantFatal: "false"},
+		{name: "disconnected", err: devices.DeviceFatal("read", io.EOF), wantCode: codes.Unavailable, wantReason: "DISCONNECTED", wantFatal: "true"},
+		{name: "inferred without device error", err: fmt.Errorf("read: %w", io.EOF), wantCode: codes.Unavailable, wantReason: "DISCONNECTED", wantFatal: "false"},
+		{name: "busy", err: devices.DeviceNonFatalKind(devices.ErrorKindBusy, "busy", nil), wantCode: codes.Aborted, wantReason: "BUSY", wantFatal: "false"},
+		{name: "out of range", err: devices.DeviceNonFatalKind(devices.ErrorKindAddressOutOfRange, "unmapped", nil), wantCode: codes.OutOfRange, wantReason: "ADDRESS_OUT_OF_RANGE", wantFatal: "false"},
+		{name: "unsupported", err: devices.DeviceNonFatalKind(devices.ErrorKindUnsupportedDomain, "no", nil), wantCode: codes.Unimplemented, wantReason: "UNSUPPORTED_DOMAIN", wantFatal: "false"},
+		{name: "protocol violation", err: devices.DeviceFatalKind(devices.ErrorKindProtocolViolation, "reply", nil), wantCode: codes.Internal, wantReason: "PROTOCOL_VIOLATION", wantFatal: "true"},
+		{name: "explicit code wins", err: devices.WithCode(codes.InvalidArgument, devices.DeviceFatal("read", io.EOF)), wantCode: codes.InvalidArgument, wantReason: "DISCONNECTED", wantFatal: "true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := grpcError(tt.err)
+			if tt.wantPassed {
+				if err != tt.err {
+					t.Fatalf("grpcError() = %v, want %v unconverted", err, tt.err)
+				}
+				return
+			}
+
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Fatalf("grpcError() = %v, want status error", err)
+			}
+			if st.Code() != tt.wantCode {
+				t.Errorf("code = %v, want %v", st.Code(), tt.wantCode)
+			}
+			if st.Message() != tt.err.Error() {
+				t.Errorf("message = %q, want %q", st.Message(), tt.err.Error())
+			}
+
+			var info *errdetails.ErrorInfo
+			for _, detail := range st.Details() {
+				if info, ok = detail.(*errdetails.ErrorInfo); ok {
+					break
+				}
+			}
+			if info == nil {
+				t.Fatal("missing ErrorInfo detail")
+			}
+			if info.GetDomain() != errorInfoDomain || info.GetReason() != tt.wantReason {
+				t.Errorf("ErrorInfo = %s/%s, want %s/%s", info.GetDomain(), info.GetReason(), errorInfoDomain, tt.wantReason)
+			}
+			if fatal := info.GetMetadata()["fatal"]; fatal != tt.wantFatal {
+				t.Errorf("fatal = %q, want %q", fatal, tt.wantFatal)
+			}
+			if isFatalError(err) != (tt.wantFatal == "true") {
+				t.Errorf("isFatalError() = %v, want %v", isFatalError(err), tt.wantFatal)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/protos/sni"
+)
+
+type DeviceEmulatorService struct {
+	sni.UnimplementedDeviceEmulatorServer
+}
+
+// stateSlot converts an optional request slot to a device slot
+func stateSlot(slot *uint32) int {
+	if slot == nil {
+		return devices.CurrentStateSlot
+	}
+	return int(*slot)
+}
+
+func (s *DeviceEmulatorService) SaveState(gctx context.Context, request *sni.SaveStateRequest) (grsp *sni.SaveStateResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	capabilities := []sni.DeviceCapability{sni.DeviceCapability_SaveState}
+	if request.Slot != nil {
+		capabilities = append(capabilities, sni.DeviceCapability_SaveStateSlot)
+	}
+	if _, err := driver.HasCapabilities(capabilities...); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = device.SaveState(gctx, stateSlot(request.Slot))
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.SaveStateResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+func (s *DeviceEmulatorService) LoadState(gctx context.Context, request *sni.LoadStateRequest) (grsp *sni.LoadStateResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	capabilities := []sni.DeviceCapability{sni.DeviceCapability_LoadState}
+	if request.Slot != nil {
+		capabilities = append(capabilities, sni.DeviceCapability_LoadStateSlot)
+	}
+	if _, err := driver.HasCapabilities(capabilities...); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = device.LoadState(gctx, stateSlot(request.Slot))
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.LoadStateResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+func (s *DeviceEmulatorService) Screenshot(gctx context.Context, request *sni.ScreenshotRequest) (grsp *sni.ScreenshotResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_Screenshot); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = device.Screenshot(gctx)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ScreenshotResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"sni/cmd/sni/config"
+	"sni/devices"
 	"sni/protos/sni"
 	"sni/util"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 )
@@ -33,8 +35,8 @@ func StartGrpcServer() {
 
 	// create gRPC server:
 	GrpcServer = grpc.NewServer(
-		grpc.ChainUnaryInterceptor(logTimingInterceptor),
-		grpc.ChainStreamInterceptor(reportErrorStreamInterceptor),
+		grpc.ChainUnaryInterceptor(logTimingInterceptor, leaseUnaryInterceptor, clientUnaryInterceptor),
+		grpc.ChainStreamInterceptor(reportErrorStreamInterceptor, leaseStreamInterceptor, clientStreamInterceptor),
 		grpc.MaxRecvMsgSize(maxMessageSize),
 	)
 	sni.RegisterDevicesServer(GrpcServer, &DevicesService{})
@@ -43,6 +45,9 @@ func StartGrpcServer() {
 	sni.RegisterDeviceFilesystemServer(GrpcServer, &DeviceFilesystem{})
 	sni.RegisterDeviceInfoServer(GrpcServer, &DeviceInfoService{})
 	sni.RegisterDeviceNWAServer(GrpcServer, &DeviceNWAService{})
+	sni.RegisterDeviceAssemblyServer(GrpcServer, &DeviceAssemblyService{})
+	sni.RegisterDeviceSnapshotServer(GrpcServer, &DeviceSnapshotService{})
+	sni.RegisterDeviceEmulatorServer(GrpcServer, &DeviceEmulatorService{})
 	reflection.Register(GrpcServer)
 
 	go serveGrpc()
@@ -241,3 +246,71 @@ func reportErrorStreamInterceptor(
 
 	return
 }
+
+// leaseMetadataKey is the request metadata header clients use to present a lease acquired via AcquireLease
+const leaseMetadataKey = "sni-lease-id"
+
+func contextWithLease(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	values := md.Get(leaseMetadataKey)
+	if len(values) == 0 {
+		return ctx
+	}
+	return devices.WithLeaseID(ctx, values[0])
+}
+
+func leaseUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (rsp interface{}, err error) {
+	return handler(contextWithLease(ctx), req)
+}
+
+// contextServerStream overrides the stream's context to carry the client's lease or name
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context { return s.ctx }
+
+func leaseStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	return handler(srv, &contextServerStream{ServerStream: ss, ctx: contextWithLease(ss.Context())})
+}
+
+// contextWithClient names the client by its peer address for the journal
+func contextWithClient(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ctx
+	}
+	return devices.WithClient(ctx, "grpc:"+p.Addr.String())
+}
+
+func clientUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (rsp interface{}, err error) {
+	return handler(contextWithClient(ctx), req)
+}
+
+func clientStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	return handler(srv, &contextServerStream{ServerStream: ss, ctx: contextWithClient(ss.Context())})
+}
@@ -1,16 +1,20 @@
 package grpcimpl
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"github.com/alttpo/snes/timing"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"io"
 	"net/url"
 	"sni/devices"
 	"sni/devices/snes/mapping"
+	"sni/devices/snes/symbols"
 	"sni/protos/sni"
 	"strings"
+	"time"
 )
 
 type DeviceMemoryService struct {
@@ -353,8 +357,19 @@ func (s *DeviceMemoryService) StreamRead(stream sni.DeviceMemory_StreamReadServe
 		}
 		grsp, gerr := s.MultiRead(stream.Context(), in)
 		if gerr != nil {
-			// TODO: stream errors as responses?
-			return gerr
+			// report the error as a response and keep the stream open unless it is fatal:
+			rspStatus := streamResponseStatus(gerr)
+			err = stream.Send(&sni.MultiReadMemoryResponse{
+				Uri:    in.GetUri(),
+				Status: rspStatus,
+			})
+			if err != nil {
+				return err
+			}
+			if rspStatus.Fatal {
+				return gerr
+			}
+			continue
 		}
 		err = stream.Send(grsp)
 		if err != nil {
@@ -374,8 +389,19 @@ func (s *DeviceMemoryService) StreamWrite(stream sni.DeviceMemory_StreamWriteSer
 		}
 		grsp, gerr := s.MultiWrite(stream.Context(), in)
 		if gerr != nil {
-			// TODO: stream errors as responses?
-			return gerr
+			// report the error as a response and keep the stream open unless it is fatal:
+			rspStatus := streamResponseStatus(gerr)
+			err = stream.Send(&sni.MultiWriteMemoryResponse{
+				Uri:    in.GetUri(),
+				Status: rspStatus,
+			})
+			if err != nil {
+				return err
+			}
+			if rspStatus.Fatal {
+				return gerr
+			}
+			continue
 		}
 		err = stream.Send(grsp)
 		if err != nil {
@@ -384,6 +410,263 @@ func (s *DeviceMemoryService) StreamWrite(stream sni.DeviceMemory_StreamWriteSer
 	}
 }
 
+func streamResponseStatus(err error) *sni.ResponseStatus {
+	return &sni.ResponseStatus{
+		Code:    int32(status.Code(err)),
+		Message: status.Convert(err).Message(),
+		Fatal:   isFatalError(err),
+	}
+}
+
+func (s *DeviceMemoryService) CompareAndWrite(
+	gctx context.Context,
+	request *sni.CompareAndWriteMemoryRequest,
+) (grsp *sni.CompareAndWriteMemoryResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if actual, expected := len(request.GetCompare()), len(request.GetData()); actual != expected {
+		return nil, status.Errorf(codes.InvalidArgument, "compare length %d must equal data length %d", actual, expected)
+	}
+	if len(request.GetData()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "data must not be empty")
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory, sni.DeviceCapability_WriteMemory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var rsp devices.MemoryCompareAndWriteResponse
+	rsp, gerr = device.CompareAndWriteMemory(gctx, devices.MemoryCompareAndWriteRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:       request.GetRequestAddress(),
+			AddressSpace:  request.GetRequestAddressSpace(),
+			MemoryMapping: request.GetRequestMemoryMapping(),
+		},
+		Compare: request.GetCompare(),
+		Data:    request.GetData(),
+	})
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.CompareAndWriteMemoryResponse{
+		Uri:                  request.Uri,
+		RequestAddress:       rsp.RequestAddress.Address,
+		RequestAddressSpace:  rsp.RequestAddress.AddressSpace,
+		RequestMemoryMapping: rsp.RequestAddress.MemoryMapping,
+		DeviceAddress:        rsp.DeviceAddress.Address,
+		DeviceAddressSpace:   rsp.DeviceAddress.AddressSpace,
+		Written:              rsp.Written,
+	}
+	return
+}
+
+func (s *DeviceMemoryService) ReadSymbols(
+	gctx context.Context,
+	request *sni.ReadSymbolsRequest,
+) (grsp *sni.ReadSymbolsResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(request.GetNames()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "names must not be empty")
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var symbolMap *symbols.Map
+	if request.GetSymbolMap() != "" {
+		symbolMap, gerr = symbols.ByName(request.GetSymbolMap())
+	} else {
+		// finding the symbol map for the loaded ROM needs its file name and hash:
+		if _, err := driver.HasCapabilities(sni.DeviceCapability_FetchFields); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		symbolMap, gerr = symbols.Resolve(gctx, uri.String(), device)
+	}
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var syms []*symbols.Symbol
+	syms, err = symbolMap.Lookup(request.GetNames()...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// only SNES A-bus addresses need a memory mapping to translate:
+	memoryMapping := request.GetMemoryMapping()
+	if memoryMapping == sni.MemoryMapping_Unknown {
+		for _, sym := range syms {
+			if sym.AddressSpace != sni.AddressSpace_SnesABus {
+				continue
+			}
+			memoryMapping, _, _, gerr = mapping.Detect(gctx, device, nil, nil)
+			if gerr != nil {
+				return nil, grpcError(gerr)
+			}
+			break
+		}
+	}
+
+	var values []symbols.Value
+	values, gerr = symbols.Read(gctx, device, syms, memoryMapping)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ReadSymbolsResponse{
+		Uri:       request.Uri,
+		SymbolMap: symbolMap.Name,
+		Values:    make([]*sni.SymbolValue, 0, len(values)),
+	}
+	for _, v := range values {
+		grsp.Values = append(grsp.Values, &sni.SymbolValue{
+			Name:         v.Symbol.Name,
+			Type:         string(v.Symbol.Type),
+			Address:      v.Symbol.Address,
+			AddressSpace: v.Symbol.AddressSpace,
+			Data:         v.Data,
+			Value:        v.Value,
+			Description:  v.Symbol.Description,
+		})
+	}
+	return
+}
+
+func (s *DeviceMemoryService) WatchMemory(request *sni.WatchMemoryRequest, stream sni.DeviceMemory_WatchMemoryServer) (gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	interval := time.Duration(request.GetPollIntervalNanoseconds())
+	if interval <= 0 {
+		interval = timing.Frame
+	}
+
+	reads := make([]devices.MemoryReadRequest, 0, len(request.Requests))
+	for _, req := range request.Requests {
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       req.GetRequestAddress(),
+				AddressSpace:  req.GetRequestAddressSpace(),
+				MemoryMapping: req.GetRequestMemoryMapping(),
+			},
+			Size: int(req.GetSize()),
+		})
+	}
+	if len(reads) == 0 {
+		return status.Error(codes.InvalidArgument, "watch requires at least one read request")
+	}
+
+	ctx := stream.Context()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// last known data per read request; nil until the first poll completes:
+	last := make([][]byte, len(reads))
+	sequence := uint64(0)
+	for {
+		var mrsps []devices.MemoryReadResponse
+		mrsps, gerr = device.MultiReadMemory(ctx, reads...)
+		if gerr != nil {
+			if ctx.Err() != nil {
+				// client went away mid-poll:
+				return nil
+			}
+			return grpcError(gerr)
+		}
+		if actual, expected := len(mrsps), len(reads); actual != expected {
+			return status.Errorf(
+				codes.Internal,
+				"multi read must have equal number of responses and requests; actual %d expected %d",
+				actual,
+				expected,
+			)
+		}
+
+		changes := make([]*sni.WatchMemoryChange, 0, len(mrsps))
+		for j, mrsp := range mrsps {
+			if actual, expected := len(mrsp.Data), reads[j].Size; actual != expected {
+				return status.Errorf(
+					codes.Internal,
+					"read[%d] must return data of the requested size; actual $%x expected $%x",
+					j,
+					actual,
+					expected,
+				)
+			}
+
+			if last[j] != nil && bytes.Equal(last[j], mrsp.Data) {
+				continue
+			}
+
+			changes = append(changes, &sni.WatchMemoryChange{
+				RequestIndex: uint32(j),
+				Response: &sni.ReadMemoryResponse{
+					RequestAddress:       mrsp.RequestAddress.Address,
+					RequestAddressSpace:  mrsp.RequestAddress.AddressSpace,
+					RequestMemoryMapping: mrsp.RequestAddress.MemoryMapping,
+					DeviceAddress:        mrsp.DeviceAddress.Address,
+					DeviceAddressSpace:   mrsp.DeviceAddress.AddressSpace,
+					Data:                 mrsp.Data,
+				},
+				OldData: last[j],
+			})
+			last[j] = mrsp.Data
+		}
+
+		if len(changes) > 0 {
+			sequence++
+			err = stream.Send(&sni.WatchMemoryResponse{
+				Uri:      request.Uri,
+				Sequence: sequence,
+				Changes:  changes,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
 func ReadMemoryRequestString(m *sni.ReadMemoryRequest) string {
 	return fmt.Sprintf(
 		"{address:%s,size:%#x}",
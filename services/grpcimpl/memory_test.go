@@ -0,0 +1,92 @@
+package grpcimpl
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"sni/devices"
+	"sni/protos/sni"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeviceMemoryService_WatchMemory(t *testing.T) {
+	registerDrivers()
+
+	uri := url.URL{Scheme: "mock", Opaque: "mock"}
+	driver, device, err := devices.DeviceByUri(&uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(driver.DisconnectAll)
+
+	write := func(address uint32, data ...byte) {
+		t.Helper()
+
+		_, err := device.MultiWriteMemory(context.Background(), devices.MemoryWriteRequest{
+			RequestAddress: devices.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_FxPakPro},
+			Data:           data,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(0xF50000, 1, 2, 3, 4)
+	write(0xF50100, 5, 6)
+
+	stream, cancel := newFakeStream[sni.WatchMemoryResponse](t)
+	done := make(chan error, 1)
+	go func() {
+		done <- (&DeviceMemoryService{}).WatchMemory(&sni.WatchMemoryRequest{
+			Uri: uri.String(),
+			Requests: []*sni.ReadMemoryRequest{
+				{RequestAddress: 0xF50000, RequestAddressSpace: sni.AddressSpace_FxPakPro, Size: 4},
+				{RequestAddress: 0xF50100, RequestAddressSpace: sni.AddressSpace_FxPakPro, Size: 2},
+			},
+			PollIntervalNanoseconds: 1_000_000,
+		}, stream)
+	}()
+
+	// the first poll reports every region:
+	rsp := stream.receive(t)
+	if rsp.Sequence != 1 || len(rsp.Changes) != 2 {
+		t.Fatalf("first response = %v", rsp)
+	}
+	if !bytes.Equal(rsp.Changes[0].Response.Data, []byte{1, 2, 3, 4}) || rsp.Changes[0].OldData != nil {
+		t.Fatalf("first change = %v", rsp.Changes[0])
+	}
+
+	// only the changed region is reported with its old and new data:
+	write(0xF50100, 7, 8)
+	rsp = stream.receive(t)
+	if rsp.Sequence != 2 || len(rsp.Changes) != 1 {
+		t.Fatalf("second response = %v", rsp)
+	}
+	change := rsp.Changes[0]
+	if change.RequestIndex != 1 || !bytes.Equal(change.OldData, []byte{5, 6}) || !bytes.Equal(change.Response.Data, []byte{7, 8}) {
+		t.Fatalf("second change = %v", change)
+	}
+
+	write(0xF50000, 1, 2, 3, 9)
+	rsp = stream.receive(t)
+	if rsp.Sequence != 3 || len(rsp.Changes) != 1 || rsp.Changes[0].RequestIndex != 0 {
+		t.Fatalf("third response = %v", rsp)
+	}
+
+	cancel()
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeviceMemoryService_WatchMemory_noRequests(t *testing.T) {
+	registerDrivers()
+
+	stream, _ := newFakeStream[sni.WatchMemoryResponse](t)
+	err := (&DeviceMemoryService{}).WatchMemory(&sni.WatchMemoryRequest{Uri: "mock:mock"}, stream)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("WatchMemory() = %v, want InvalidArgument", err)
+	}
+}
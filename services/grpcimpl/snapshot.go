@@ -0,0 +1,139 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/devices/snapshots"
+	"sni/protos/sni"
+)
+
+type DeviceSnapshotService struct {
+	sni.UnimplementedDeviceSnapshotServer
+}
+
+func snapshotInfo(info snapshots.Info) *sni.SnapshotInfo {
+	regions := make([]*sni.SnapshotRegion, 0, len(info.Regions))
+	for _, region := range info.Regions {
+		regions = append(regions, &sni.SnapshotRegion{
+			Address: region.Address,
+			Size:    region.Size,
+		})
+	}
+
+	return &sni.SnapshotInfo{
+		Name:                     info.Name,
+		CreatedAtUnixNanoseconds: info.CreatedAt.UnixNano(),
+		RomFileName:              info.RomFileName,
+		RomHashType:              info.RomHashType,
+		RomHashValue:             info.RomHashValue,
+		Regions:                  regions,
+	}
+}
+
+func (s *DeviceSnapshotService) Capture(gctx context.Context, request *sni.CaptureSnapshotRequest) (grsp *sni.CaptureSnapshotResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory, sni.DeviceCapability_FetchFields); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	regions := make([]snapshots.Region, 0, len(request.GetRegions()))
+	for _, region := range request.GetRegions() {
+		if region.GetSize() == 0 {
+			return nil, status.Error(codes.InvalidArgument, "region size must not be zero")
+		}
+		regions = append(regions, snapshots.Region{
+			Address: region.GetAddress(),
+			Size:    region.GetSize(),
+		})
+	}
+
+	// pause emulation while reading where possible so that the regions are captured from the same frame:
+	_, perr := driver.HasCapabilities(sni.DeviceCapability_PauseUnpauseEmulation)
+
+	var info snapshots.Info
+	info, gerr = snapshots.Capture(gctx, device, request.GetName(), regions, perr == nil)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.CaptureSnapshotResponse{
+		Uri:      request.Uri,
+		Snapshot: snapshotInfo(info),
+	}
+	return
+}
+
+func (s *DeviceSnapshotService) Restore(gctx context.Context, request *sni.RestoreSnapshotRequest) (grsp *sni.RestoreSnapshotResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_WriteMemory, sni.DeviceCapability_FetchFields); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	// pause emulation while restoring where possible so the game does not run on a partially restored snapshot:
+	_, perr := driver.HasCapabilities(sni.DeviceCapability_PauseUnpauseEmulation)
+
+	var info snapshots.Info
+	info, gerr = snapshots.Restore(gctx, device, request.GetName(), perr == nil)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.RestoreSnapshotResponse{
+		Uri:      request.Uri,
+		Snapshot: snapshotInfo(info),
+	}
+	return
+}
+
+func (s *DeviceSnapshotService) List(gctx context.Context, request *sni.ListSnapshotsRequest) (grsp *sni.ListSnapshotsResponse, gerr error) {
+	var infos []snapshots.Info
+	infos, gerr = snapshots.List()
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ListSnapshotsResponse{
+		Snapshots: make([]*sni.SnapshotInfo, 0, len(infos)),
+	}
+	for _, info := range infos {
+		grsp.Snapshots = append(grsp.Snapshots, snapshotInfo(info))
+	}
+	return
+}
+
+func (s *DeviceSnapshotService) Delete(gctx context.Context, request *sni.DeleteSnapshotRequest) (grsp *sni.DeleteSnapshotResponse, gerr error) {
+	gerr = snapshots.Delete(request.GetName())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.DeleteSnapshotResponse{
+		Name: request.Name,
+	}
+	return
+}
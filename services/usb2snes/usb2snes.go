@@ -145,7 +145,12 @@ func WebsocketHandler(rw http.ResponseWriter, req *http.Request) {
 	}
 
 	clientName := conn.RemoteAddr().String()
+	// deviceErr is set when the connection is closed due to a device error so the client can learn why:
+	var deviceErr error
 	defer func() {
+		if deviceErr != nil {
+			_ = ws.WriteFrame(conn, closeFrameForError(deviceErr))
+		}
 		log.Printf("usb2snes: %s: %s disconnected\n", clientName, conn.RemoteAddr())
 		conn.Close()
 	}()
@@ -247,6 +252,27 @@ serverLoop:
 
 		switch cmd.Opcode {
 		case "DeviceList":
+			// optional Flags name capabilities that listed devices must all support:
+			capabilities := make([]sni.DeviceCapability, 0, len(cmd.Flags))
+			unknownFlag := false
+			for _, flag := range cmd.Flags {
+				capability, ok := sni.DeviceCapability_value[flag]
+				if !ok {
+					// no device supports an unknown capability so the list is empty:
+					log.Printf("usb2snes: %s: %s unknown capability flag '%s'\n", clientName, cmd.Opcode, flag)
+					unknownFlag = true
+					continue
+				}
+				capabilities = append(capabilities, sni.DeviceCapability(capability))
+			}
+			if unknownFlag {
+				results.Results = make([]string, 0)
+				if !replyJson() {
+					break serverLoop
+				}
+				break
+			}
+
 			descriptors := make([]devices.DeviceDescriptor, 0, 10)
 			for _, driver := range devices.Drivers() {
 				if config.VerboseLogging {
@@ -265,6 +291,9 @@ serverLoop:
 
 			results.Results = make([]string, 0, 10)
 			for _, descriptor := range descriptors {
+				if ok, _ := devices.CheckCapabilities(capabilities, descriptor.Capabilities); !ok {
+					continue
+				}
 				results.Results = append(results.Results, descriptor.Uri.String())
 			}
 
@@ -307,6 +336,7 @@ serverLoop:
 			driver, device, err = devices.DeviceByUri(attachedUri)
 			if err != nil {
 				log.Printf("usb2snes: %s: could not open device by uri '%s': %s\n", clientName, uriString, err)
+				deviceErr = err
 				break serverLoop
 			}
 
@@ -336,12 +366,23 @@ serverLoop:
 				sni.Field_DeviceVersion,
 				sni.Field_DeviceName,
 				sni.Field_RomFileName,
+				sni.Field_DeviceFeatures,
+				sni.Field_CartridgeFeatures,
 			)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %v; falling back to default Info values\n", clientName, cmd.Opcode, err)
 				results.Results = []string{"1.9.0-usb-v9", "SD2SNES", "No Info"}
 			} else {
 				results.Results = []string{fields[0], fields[1], fields[2]}
+				// feature flags follow as "FEAT_" prefixed names like QUsb2snes reports them:
+				for _, features := range fields[3:] {
+					if features == "" {
+						continue
+					}
+					for _, name := range strings.Split(features, ",") {
+						results.Results = append(results.Results, "FEAT_"+name)
+					}
+				}
 			}
 
 			if !replyJson() {
@@ -431,9 +472,10 @@ serverLoop:
 			var rsps []devices.MemoryReadResponse
 			if len(reqs) > 0 {
 				// issue the read request:
-				rsps, err = device.MultiReadMemory(context.Background(), reqs...)
+				rsps, err = device.MultiReadMemory(devices.WithClient(context.Background(), "usb2snes:"+clientName), reqs...)
 				if err != nil {
 					log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+					deviceErr = err
 					break serverLoop
 				}
 
@@ -560,9 +602,10 @@ serverLoop:
 
 			// issue the read request:
 			var rsps []devices.MemoryWriteResponse
-			rsps, err = device.MultiWriteMemory(context.Background(), reqs...)
+			rsps, err = device.MultiWriteMemory(devices.WithClient(context.Background(), "usb2snes:"+clientName), reqs...)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 			if config.VerboseLogging {
@@ -581,6 +624,7 @@ serverLoop:
 			err = device.ResetSystem(context.Background())
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 			break
@@ -594,6 +638,47 @@ serverLoop:
 			err = device.ResetToMenu(context.Background())
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
+				break serverLoop
+			}
+			break
+
+		case "PowerCycle":
+			if device == nil {
+				log.Printf("usb2snes: %s: %s requires Attach first\n", clientName, cmd.Opcode)
+				break serverLoop
+			}
+
+			err = device.PowerCycle(context.Background())
+			if err != nil {
+				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
+				break serverLoop
+			}
+			break
+
+		case "SetRealTimeClock":
+			if device == nil {
+				log.Printf("usb2snes: %s: %s requires Attach first\n", clientName, cmd.Opcode)
+				break serverLoop
+			}
+
+			// optional operand is the time to set in seconds since the unix epoch:
+			t := time.Now()
+			if len(cmd.Operands) >= 1 {
+				var unixSeconds int64
+				unixSeconds, err = strconv.ParseInt(cmd.Operands[0], 10, 64)
+				if err != nil {
+					log.Printf("usb2snes: %s: %s: could not parse unix seconds: %s\n", clientName, cmd.Opcode, err)
+					break serverLoop
+				}
+				t = time.Unix(unixSeconds, 0)
+			}
+
+			err = device.SetRealTimeClock(context.Background(), t)
+			if err != nil {
+				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 			break
@@ -612,6 +697,7 @@ serverLoop:
 			err = device.BootFile(context.Background(), cmd.Operands[0])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 			break
@@ -631,6 +717,7 @@ serverLoop:
 			entries, err = device.ReadDirectory(context.Background(), cmd.Operands[0])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 
@@ -658,6 +745,7 @@ serverLoop:
 			err = device.MakeDirectory(context.Background(), cmd.Operands[0])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 			break
@@ -676,6 +764,7 @@ serverLoop:
 			err = device.RemoveFile(context.Background(), cmd.Operands[0])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 			break
@@ -694,6 +783,7 @@ serverLoop:
 			err = device.RenameFile(context.Background(), cmd.Operands[0], cmd.Operands[1])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 			break
@@ -732,6 +822,7 @@ serverLoop:
 			n, err = device.GetFile(context.Background(), cmd.Operands[0], wsw, sizeReceived, progress)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 			if config.VerboseLogging {
@@ -774,6 +865,7 @@ serverLoop:
 			n, err = device.PutFile(context.Background(), cmd.Operands[0], size, wsr, progress)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				deviceErr = err
 				break serverLoop
 			}
 			if config.VerboseLogging {
@@ -792,3 +884,34 @@ serverLoop:
 		}
 	}
 }
+
+// statusTryAgainLater is the IANA registered websocket close code not defined by gobwas/ws
+const statusTryAgainLater ws.StatusCode = 1013
+
+// maxCloseReasonLength is the maximum control frame payload size less the 2-byte status code
+const maxCloseReasonLength = 123
+
+// closeFrameForError creates a websocket close frame whose status code and reason describe the device error kind
+func closeFrameForError(err error) ws.Frame {
+	kind := devices.KindOf(err)
+
+	var code ws.StatusCode
+	switch kind {
+	case devices.ErrorKindTimeout, devices.ErrorKindBusy:
+		code = statusTryAgainLater
+	case devices.ErrorKindDisconnected:
+		code = ws.StatusGoingAway
+	case devices.ErrorKindAddressOutOfRange, devices.ErrorKindUnsupportedDomain:
+		code = ws.StatusUnsupportedData
+	default:
+		code = ws.StatusInternalServerError
+	}
+
+	reason := fmt.Sprintf("%s: %v", kind, err)
+	if len(reason) > maxCloseReasonLength {
+		// don't leave a partial UTF-8 sequence behind:
+		reason = strings.ToValidUTF8(reason[:maxCloseReasonLength], "")
+	}
+
+	return ws.NewCloseFrame(ws.NewCloseFrameBody(code, reason))
+}
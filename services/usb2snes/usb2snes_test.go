@@ -0,0 +1,63 @@
+package usb2snes
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sni/devices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gobwas/ws"
+)
+
+func TestCloseFrameForError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode ws.StatusCode
+	}{
+		{"timeout", devices.DeviceNonFatal("read", context.DeadlineExceeded), statusTryAgainLater},
+		{"busy", devices.DeviceNonFatalKind(devices.ErrorKindBusy, "busy", nil), statusTryAgainLater},
+		{"disconnected", devices.DeviceFatal("read", io.EOF), ws.StatusGoingAway},
+		{"out of range", devices.DeviceNonFatalKind(devices.ErrorKindAddressOutOfRange, "unmapped", nil), ws.StatusUnsupportedData},
+		{"unsupported", devices.DeviceNonFatalKind(devices.ErrorKindUnsupportedDomain, "no", nil), ws.StatusUnsupportedData},
+		{"protocol violation", devices.DeviceFatalKind(devices.ErrorKindProtocolViolation, "reply", nil), ws.StatusInternalServerError},
+		{"unknown", errors.New("failed"), ws.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frame := closeFrameForError(tt.err)
+			if frame.Header.OpCode != ws.OpClose {
+				t.Fatalf("opcode = %v, want %v", frame.Header.OpCode, ws.OpClose)
+			}
+
+			code, reason := ws.ParseCloseFrameData(frame.Payload)
+			if code != tt.wantCode {
+				t.Errorf("code = %d, want %d", code, tt.wantCode)
+			}
+			if want := devices.KindOf(tt.err).String() + ": " + tt.err.Error(); reason != want {
+				t.Errorf("reason = %q, want %q", reason, want)
+			}
+		})
+	}
+}
+
+func TestCloseFrameForError_longReason(t *testing.T) {
+	// a multi-byte rune straddles the length limit:
+	err := errors.New(strings.Repeat("x", maxCloseReasonLength-len("UNKNOWN: ")-1) + "é")
+
+	frame := closeFrameForError(err)
+	if len(frame.Payload) > 125 {
+		t.Fatalf("payload is %d bytes, want at most 125", len(frame.Payload))
+	}
+
+	_, reason := ws.ParseCloseFrameData(frame.Payload)
+	if !utf8.ValidString(reason) {
+		t.Fatalf("reason %q is not valid UTF-8", reason)
+	}
+	if want := "UNKNOWN: " + strings.Repeat("x", maxCloseReasonLength-len("UNKNOWN: ")-1); reason != want {
+		t.Fatalf("reason = %q, want %q", reason, want)
+	}
+}
@@ -18,6 +18,8 @@ type UDPClient struct {
 
 	muteLog bool
 
+	// connLock guards c and the connection state since Close may be called from any goroutine:
+	connLock    sync.Mutex
 	isConnected bool
 	isClosed    bool
 
@@ -35,7 +37,21 @@ func MakeUDPClient(name string, c *UDPClient) *UDPClient {
 	return c
 }
 
-func (c *UDPClient) IsClosed() bool { return c.isClosed }
+func (c *UDPClient) IsClosed() bool {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+	return c.isClosed
+}
+
+// conn returns the connection or net.ErrClosed once closed
+func (c *UDPClient) conn() (*net.UDPConn, error) {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+	if c.isClosed {
+		return nil, net.ErrClosed
+	}
+	return c.c, nil
+}
 
 func (c *UDPClient) MuteLog(muted bool) {
 	c.muteLog = muted
@@ -44,16 +60,17 @@ func (c *UDPClient) MuteLog(muted bool) {
 func (c *UDPClient) Address() *net.UDPAddr { return c.addr }
 
 func (c *UDPClient) WriteWithDeadline(m []byte, deadline time.Time) (err error) {
-	if c.isClosed {
-		return net.ErrClosed
+	var conn *net.UDPConn
+	if conn, err = c.conn(); err != nil {
+		return
 	}
 
-	err = c.c.SetWriteDeadline(deadline)
+	err = conn.SetWriteDeadline(deadline)
 	if err != nil {
 		return
 	}
 
-	_, err = c.c.Write(m)
+	_, err = conn.Write(m)
 	if err != nil {
 		if isTimeoutError(err) {
 			_ = c.Close()
@@ -67,24 +84,32 @@ func (c *UDPClient) WriteWithDeadline(m []byte, deadline time.Time) (err error)
 }
 
 func (c *UDPClient) ReadWithDeadline(deadline time.Time) (b []byte, err error) {
-	if c.isClosed {
-		return nil, net.ErrClosed
+	b, err = c.ReceiveWithDeadline(deadline)
+	if err != nil && isTimeoutError(err) {
+		_ = c.Close()
+	}
+	return
+}
+
+// ReceiveWithDeadline waits for a packet like ReadWithDeadline but keeps the connection open when the deadline
+// passes so that the caller may retry
+func (c *UDPClient) ReceiveWithDeadline(deadline time.Time) (b []byte, err error) {
+	var conn *net.UDPConn
+	if conn, err = c.conn(); err != nil {
+		return
 	}
 
 	// wait for a packet from UDP socket:
-	err = c.c.SetReadDeadline(deadline)
+	err = conn.SetReadDeadline(deadline)
 	if err != nil {
 		return
 	}
 
 	var n int
 	b = make([]byte, 65536)
-	n, _, err = c.c.ReadFromUDP(b)
+	n, _, err = conn.ReadFromUDP(b)
 	if err != nil {
 		b = nil
-		if isTimeoutError(err) {
-			_ = c.Close()
-		}
 		if errors.Is(err, net.ErrClosed) {
 			_ = c.Close()
 		}
@@ -96,7 +121,7 @@ func (c *UDPClient) ReadWithDeadline(deadline time.Time) (b []byte, err error) {
 }
 
 func (c *UDPClient) WriteThenRead(m []byte, deadline time.Time) (rsp []byte, err error) {
-	if c.isClosed {
+	if c.IsClosed() {
 		return nil, net.ErrClosed
 	}
 
@@ -124,10 +149,27 @@ func (c *UDPClient) Unlock() {
 	c.seqLock.Unlock()
 }
 
-func (c *UDPClient) SetReadDeadline(t time.Time) error  { return c.c.SetReadDeadline(t) }
-func (c *UDPClient) SetWriteDeadline(t time.Time) error { return c.c.SetWriteDeadline(t) }
+func (c *UDPClient) SetReadDeadline(t time.Time) error {
+	conn, err := c.conn()
+	if err != nil {
+		return err
+	}
+	return conn.SetReadDeadline(t)
+}
 
-func (c *UDPClient) IsConnected() bool { return c.isConnected }
+func (c *UDPClient) SetWriteDeadline(t time.Time) error {
+	conn, err := c.conn()
+	if err != nil {
+		return err
+	}
+	return conn.SetWriteDeadline(t)
+}
+
+func (c *UDPClient) IsConnected() bool {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+	return c.isConnected
+}
 
 func (c *UDPClient) log(fmt string, args ...interface{}) {
 	if c.muteLog {
@@ -137,6 +179,8 @@ func (c *UDPClient) log(fmt string, args ...interface{}) {
 }
 
 func (c *UDPClient) LocalAddr() *net.UDPAddr {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
 	if c.c == nil {
 		return nil
 	}
@@ -144,6 +188,8 @@ func (c *UDPClient) LocalAddr() *net.UDPAddr {
 }
 
 func (c *UDPClient) RemoteAddr() *net.UDPAddr {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
 	if c.c == nil {
 		return nil
 	}
@@ -157,6 +203,9 @@ func (c *UDPClient) SetLocalAddr(addr *net.UDPAddr) {
 func (c *UDPClient) Connect(addr *net.UDPAddr) (err error) {
 	c.log("%s: connect to server '%s'\n", c.name, addr)
 
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+
 	if c.isConnected {
 		return fmt.Errorf("%s: already connected", c.name)
 	}
@@ -177,7 +226,7 @@ func (c *UDPClient) Connect(addr *net.UDPAddr) (err error) {
 func (c *UDPClient) Disconnect() {
 	c.log("%s: disconnect from server '%s'\n", c.name, c.addr)
 
-	if !c.isConnected {
+	if !c.IsConnected() {
 		return
 	}
 
@@ -191,6 +240,9 @@ func (c *UDPClient) Disconnect() {
 }
 
 func (c *UDPClient) Close() (err error) {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+
 	if !c.isConnected {
 		return
 	}